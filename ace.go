@@ -0,0 +1,68 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !noace
+// +build !noace
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/plimble/ace"
+)
+
+var _ = registerRouter(benchRouter{name: "Ace", pkg: "github.com/plimble/ace", load: loadAce})
+
+// Ace
+func aceHandle(_ *ace.C) {}
+
+func aceHandleWrite(c *ace.C) {
+	io.WriteString(c.Writer, c.Param("name"))
+}
+
+func aceHandleTest(c *ace.C) {
+	io.WriteString(c.Writer, c.Request.RequestURI)
+}
+
+func aceMiddleware(c *ace.C) {
+	middlewareCalls++
+	c.Next()
+}
+
+func aceHandleParams(names []string) ace.HandlerFunc {
+	return func(c *ace.C) {
+		for _, name := range names {
+			io.WriteString(c.Writer, c.Param(name))
+			io.WriteString(c.Writer, "\n")
+		}
+	}
+}
+
+func loadAce(routes []route) http.Handler {
+	h := []ace.HandlerFunc{aceHandle}
+	if loadTestHandler {
+		h = []ace.HandlerFunc{aceHandleTest}
+	}
+
+	router := ace.New()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = []ace.HandlerFunc{aceHandleParams(paramNames(route.path))}
+		}
+		handlers := make([]ace.HandlerFunc, 0, loadMiddlewares+len(h))
+		for i := 0; i < loadMiddlewares; i++ {
+			handlers = append(handlers, aceMiddleware)
+		}
+		router.Handle(route.method, route.path, append(handlers, h...))
+	}
+	return router
+}
+
+func loadAceSingle(method, path string, handle ace.HandlerFunc) http.Handler {
+	router := ace.New()
+	router.Handle(method, path, []ace.HandlerFunc{handle})
+	return router
+}
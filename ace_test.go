@@ -0,0 +1,242 @@
+//go:build !noace
+// +build !noace
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkAce_Param(b *testing.B) {
+	router := loadAceSingle("GET", "/user/:name", aceHandle)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkAce_Param5(b *testing.B) {
+	router := loadAceSingle("GET", fiveColon, aceHandle)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkAce_Param20(b *testing.B) {
+	router := loadAceSingle("GET", twentyColon, aceHandle)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkAce_ParamWrite(b *testing.B) {
+	router := loadAceSingle("GET", "/user/:name", aceHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkAce_Param5Write(b *testing.B) {
+	router := loadAceSingle("GET", fiveColon, aceHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkAce_Param20Write(b *testing.B) {
+	router := loadAceSingle("GET", twentyColon, aceHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkAce_ParamEncodedWrite(b *testing.B) {
+	router := loadAceSingle("GET", "/user/:name", aceHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkAce_ParamSlashWrite(b *testing.B) {
+	router := loadAceSingle("GET", "/user/:name", aceHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkAce_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Ace"], req)
+}
+
+// Param
+func BenchmarkAce_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Ace"], req)
+}
+
+// Param and write all params
+func BenchmarkAce_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadAce, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkAce_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Ace"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkAce_GithubLoad(b *testing.B) {
+	benchLoad(b, loadAce, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkAce_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Ace"], req)
+}
+
+// One Param
+func BenchmarkAce_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Ace"], req)
+}
+
+// Two Params
+func BenchmarkAce_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Ace"], req)
+}
+
+// One Param and write
+func BenchmarkAce_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadAce, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkAce_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadAce, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkAce_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Ace"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkAce_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadAce, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkAce_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Ace"], req)
+}
+
+// One Param
+func BenchmarkAce_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Ace"], req)
+}
+
+// Two Params
+func BenchmarkAce_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Ace"], req)
+}
+
+// All Routes
+func BenchmarkAce_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Ace"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkAce_ParseLoad(b *testing.B) {
+	benchLoad(b, loadAce, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkAce_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Ace"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkAce_StaticLoad(b *testing.B) {
+	benchLoad(b, loadAce, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkAce_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Ace"], req)
+}
+
+// Double slash
+func BenchmarkAce_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Ace"], req)
+}
+
+// Dot segment
+func BenchmarkAce_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Ace"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkAce_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadAce, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkAce_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadAce, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkAce_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadAce, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkAce_GithubReload(b *testing.B) {
+	benchReload(b, loadAce, githubAPI)
+}
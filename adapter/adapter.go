@@ -0,0 +1,176 @@
+// Package adapter lets routers outside of the benchmark suite take part in it.
+//
+// A router is benchmarked through an Adapter, which loads the routes of an API
+// into the router with one of the handlers of the suite. The package of the
+// adapter registers it in its init function:
+//
+//	func init() {
+//		adapter.Register("MyRouter", "example.com/team/router", myRouterAdapter{})
+//	}
+//
+// and is compiled into the suite by a blank import, see external.go.
+//
+// Two versions of the same router are compared by registering the same adapter
+// compiled against two import paths of the router with RegisterVersion, e.g.
+// as HttpRouter@old and HttpRouter@new, see the command candidate.
+package adapter
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Route is a route of an API. Parameters in the path are written as :name,
+// e.g. /users/:user/repos; adapters translate them to the syntax of their
+// router.
+type Route struct {
+	Method string
+	Path   string
+}
+
+// Handler selects the handler an adapter registers for a route.
+type Handler int
+
+const (
+	// Handle does nothing.
+	Handle Handler = iota
+
+	// HandleWrite writes the value of the parameter "name".
+	HandleWrite
+
+	// HandleTest writes the RequestURI of the request, which tells the tests
+	// whether the right route matched.
+	HandleTest
+
+	// HandleParams writes the values of all parameters of the route in the
+	// order of the path, each followed by a newline, see ParamNames.
+	HandleParams
+)
+
+// Capabilities tell the suite which tests and benchmarks a router can take
+// part in.
+type Capabilities struct {
+	// Params is set if the router hands out route parameters to the handler.
+	Params bool
+
+	// StaticOnly is set if the router can only match routes without
+	// parameters, it is only benchmarked with the static routes.
+	StaticOnly bool
+}
+
+// An Adapter loads routes into a new instance of a router.
+type Adapter interface {
+	// Load returns a router serving all routes with the handler h.
+	Load(routes []Route, h Handler) http.Handler
+
+	// LoadSingle returns a router serving only the route method path with the
+	// handler h.
+	LoadSingle(method, path string, h Handler) http.Handler
+
+	// Capabilities returns what the router can do.
+	Capabilities() Capabilities
+}
+
+var (
+	mu       sync.RWMutex
+	adapters = make(map[string]Adapter)
+	packages = make(map[string]string)
+	versions = make(map[string][]string)
+)
+
+// Register makes an adapter available under the name, which is used for the
+// benchmarks and in all reports. pkg is the import path of the router package
+// the adapter was compiled against, which tells the reports its version.
+// Register panics if it is called twice with the same name, with a name
+// containing an @ or with a nil adapter.
+func Register(name, pkg string, a Adapter) {
+	if strings.Contains(name, "@") {
+		panic("adapter: Register name " + name + " contains an @, see RegisterVersion")
+	}
+	register(name, pkg, a)
+}
+
+// RegisterVersion makes a version of a router available under the name
+// name@version, e.g. HttpRouter@new, with the import path of the router
+// package like Register. RegisterVersion panics like Register.
+func RegisterVersion(name, version, pkg string, a Adapter) {
+	if strings.Contains(name, "@") || strings.Contains(version, "@") {
+		panic("adapter: RegisterVersion name " + name + "@" + version + " contains more than one @")
+	}
+	register(name+"@"+version, pkg, a)
+
+	mu.Lock()
+	defer mu.Unlock()
+	versions[name] = append(versions[name], version)
+}
+
+func register(name, pkg string, a Adapter) {
+	mu.Lock()
+	defer mu.Unlock()
+	if a == nil {
+		panic("adapter: Register adapter is nil")
+	}
+	if _, dup := adapters[name]; dup {
+		panic("adapter: Register called twice for adapter " + name)
+	}
+	adapters[name] = a
+	packages[name] = pkg
+}
+
+// Names returns the sorted names of the registered adapters.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(adapters))
+	for name := range adapters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the adapter registered under the name, or nil.
+func Get(name string) Adapter {
+	mu.RLock()
+	defer mu.RUnlock()
+	return adapters[name]
+}
+
+// Package returns the import path of the router package of a registered
+// adapter, or "".
+func Package(name string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return packages[name]
+}
+
+// Versions returns the versions of a router registered with RegisterVersion,
+// in the order of registration.
+func Versions(name string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]string(nil), versions[name]...)
+}
+
+// SplitVersion splits the name of an adapter into the name of the router and
+// the version, which is empty if it was registered with Register.
+func SplitVersion(name string) (router, version string) {
+	if i := strings.LastIndex(name, "@"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// ParamNames returns the names of the parameters of a route path, e.g.
+// [user] for /users/:user/repos, in the order HandleParams writes them.
+func ParamNames(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, ":") {
+			names = append(names, segment[1:])
+		}
+	}
+	return names
+}
@@ -0,0 +1,77 @@
+// Package httprouter is the adapter of HttpRouter. It does not register
+// itself, the suite benchmarks HttpRouter directly. It is the template for
+// comparing two versions of HttpRouter: the command candidate compiles a copy of
+// it against a local checkout and registers both versions.
+//
+// Like every adapter compiled against two versions, the package refers to the
+// router package only through its import path, which the copy replaces, and
+// exports Pkg and New.
+package httprouter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/adapter"
+)
+
+// Pkg is the import path of the router package.
+const Pkg = "github.com/julienschmidt/httprouter"
+
+// New returns the adapter.
+func New() adapter.Adapter {
+	return httpRouterAdapter{}
+}
+
+type httpRouterAdapter struct{}
+
+func handle(_ http.ResponseWriter, _ *http.Request, _ httprouter.Params) {}
+
+func handleWrite(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+	io.WriteString(w, ps.ByName("name"))
+}
+
+func handleTest(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func handleParams(names []string) httprouter.Handle {
+	return func(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+		for _, name := range names {
+			io.WriteString(w, ps.ByName(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func handler(path string, h adapter.Handler) httprouter.Handle {
+	switch h {
+	case adapter.HandleWrite:
+		return handleWrite
+	case adapter.HandleTest:
+		return handleTest
+	case adapter.HandleParams:
+		return handleParams(adapter.ParamNames(path))
+	}
+	return handle
+}
+
+func (httpRouterAdapter) Load(routes []adapter.Route, h adapter.Handler) http.Handler {
+	router := httprouter.New()
+	for _, route := range routes {
+		router.Handle(route.Method, route.Path, handler(route.Path, h))
+	}
+	return router
+}
+
+func (httpRouterAdapter) LoadSingle(method, path string, h adapter.Handler) http.Handler {
+	router := httprouter.New()
+	router.Handle(method, path, handler(path, h))
+	return router
+}
+
+func (httpRouterAdapter) Capabilities() adapter.Capabilities {
+	return adapter.Capabilities{Params: true}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/adapter"
+)
+
+// Routers outside of this repository are loaded through the adapters
+// registered with the package adapter, see external.go. All adapters but the
+// ones which only match static routes take part in every test using routers.
+func init() {
+	for _, name := range adapter.Names() {
+		a := adapter.Get(name)
+		if findRouter(name) != nil {
+			panic("adapter " + name + " has the name of a router of the suite")
+		}
+
+		caps := a.Capabilities()
+		if caps.StaticOnly {
+			continue
+		}
+		registerRouter(benchRouter{name: name, pkg: adapter.Package(name), load: loadAdapter(a)})
+		if !caps.Params {
+			routersWithoutParams[name] = "its adapter does not hand out route parameters"
+		}
+	}
+}
+
+// adapterHandler returns the handler selected by loadTestHandler and
+// loadParamsHandler.
+func adapterHandler() adapter.Handler {
+	switch {
+	case loadTestHandler:
+		return adapter.HandleTest
+	case loadParamsHandler:
+		return adapter.HandleParams
+	}
+	return adapter.Handle
+}
+
+// loadAdapter returns the load function of an adapter, which wraps the router
+// in the middlewares like the loaders in routers.go.
+func loadAdapter(a adapter.Adapter) func(routes []route) http.Handler {
+	return func(routes []route) http.Handler {
+		r := make([]adapter.Route, len(routes))
+		for i, route := range routes {
+			r[i] = adapter.Route{Method: route.method, Path: route.path}
+		}
+		return httpMiddlewares(a.Load(r, adapterHandler()))
+	}
+}
+
+// the benchmarks of the suite for adapters, by the scenario in the name of the
+// benchmarks of the routers of the suite
+var adapterBenchmarks = []struct {
+	scenario string
+	static   bool // only loads static routes
+	bench    func(b *testing.B, a adapter.Adapter)
+}{
+	{"Param", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", "/user/gordon", nil)
+		benchRequest(b, a.LoadSingle("GET", "/user/:name", adapter.Handle), r)
+	}},
+	{"Param5", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", fiveRoute, nil)
+		benchRequest(b, a.LoadSingle("GET", fiveColon, adapter.Handle), r)
+	}},
+	{"Param20", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", twentyRoute, nil)
+		benchRequest(b, a.LoadSingle("GET", twentyColon, adapter.Handle), r)
+	}},
+	{"ParamWrite", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", "/user/gordon", nil)
+		benchRequest(b, a.LoadSingle("GET", "/user/:name", adapter.HandleWrite), r)
+	}},
+	{"Param5Write", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", fiveRoute, nil)
+		benchRequest(b, a.LoadSingle("GET", fiveColon, adapter.HandleParams), r)
+	}},
+	{"Param20Write", false, func(b *testing.B, a adapter.Adapter) {
+		r, _ := http.NewRequest("GET", twentyRoute, nil)
+		benchRequest(b, a.LoadSingle("GET", twentyColon, adapter.HandleParams), r)
+	}},
+	{"GithubStatic", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/user/repos", nil)
+		benchRequest(b, loadAdapter(a)(githubAPI), req)
+	}},
+	{"GithubParam", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+		benchRequest(b, loadAdapter(a)(githubAPI), req)
+	}},
+	{"GithubParamWrite", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+		benchRequest(b, loadParams(loadAdapter(a), githubAPI), req)
+	}},
+	{"GithubAll", false, func(b *testing.B, a adapter.Adapter) {
+		benchRoutes(b, loadAdapter(a)(githubAPI), githubAPI)
+	}},
+	{"GithubLoad", false, func(b *testing.B, a adapter.Adapter) {
+		benchLoad(b, loadAdapter(a), githubAPI)
+	}},
+	{"GPlusStatic", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/people", nil)
+		benchRequest(b, loadAdapter(a)(gplusAPI), req)
+	}},
+	{"GPlusParam", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+		benchRequest(b, loadAdapter(a)(gplusAPI), req)
+	}},
+	{"GPlus2Params", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+		benchRequest(b, loadAdapter(a)(gplusAPI), req)
+	}},
+	{"GPlusParamWrite", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+		benchRequest(b, loadParams(loadAdapter(a), gplusAPI), req)
+	}},
+	{"GPlus2ParamsWrite", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+		benchRequest(b, loadParams(loadAdapter(a), gplusAPI), req)
+	}},
+	{"GPlusAll", false, func(b *testing.B, a adapter.Adapter) {
+		benchRoutes(b, loadAdapter(a)(gplusAPI), gplusAPI)
+	}},
+	{"GPlusLoad", false, func(b *testing.B, a adapter.Adapter) {
+		benchLoad(b, loadAdapter(a), gplusAPI)
+	}},
+	{"ParseStatic", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/1/users", nil)
+		benchRequest(b, loadAdapter(a)(parseAPI), req)
+	}},
+	{"ParseParam", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+		benchRequest(b, loadAdapter(a)(parseAPI), req)
+	}},
+	{"Parse2Params", false, func(b *testing.B, a adapter.Adapter) {
+		req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+		benchRequest(b, loadAdapter(a)(parseAPI), req)
+	}},
+	{"ParseAll", false, func(b *testing.B, a adapter.Adapter) {
+		benchRoutes(b, loadAdapter(a)(parseAPI), parseAPI)
+	}},
+	{"ParseLoad", false, func(b *testing.B, a adapter.Adapter) {
+		benchLoad(b, loadAdapter(a), parseAPI)
+	}},
+	{"StaticAll", true, func(b *testing.B, a adapter.Adapter) {
+		benchRoutes(b, loadAdapter(a)(staticRoutes), staticRoutes)
+	}},
+	{"StaticLoad", true, func(b *testing.B, a adapter.Adapter) {
+		benchLoad(b, loadAdapter(a), staticRoutes)
+	}},
+}
+
+// linearAdapter is the adapter of BaselineLinear, which TestAdapters uses to
+// check the adapter glue of the suite.
+type linearAdapter struct{}
+
+func (linearAdapter) handle(path string, h adapter.Handler) baselineHandle {
+	switch h {
+	case adapter.HandleWrite:
+		return baselineHandlerWrite
+	case adapter.HandleTest:
+		return baselineHandlerTest
+	case adapter.HandleParams:
+		return baselineHandlerParams(adapter.ParamNames(path))
+	}
+	return baselineHandler
+}
+
+func (a linearAdapter) Load(routes []adapter.Route, h adapter.Handler) http.Handler {
+	router := new(linearRouter)
+	for _, route := range routes {
+		router.handle(route.Method, route.Path, a.handle(route.Path, h))
+	}
+	return router
+}
+
+func (a linearAdapter) LoadSingle(method, path string, h adapter.Handler) http.Handler {
+	return loadBaselineLinearSingle(method, path, a.handle(path, h))
+}
+
+func (linearAdapter) Capabilities() adapter.Capabilities {
+	return adapter.Capabilities{Params: true}
+}
+
+// TestAdapters checks the handlers of the registered adapters and of
+// linearAdapter. The routers of the adapters are tested like all others.
+func TestAdapters(t *testing.T) {
+	tested := map[string]adapter.Adapter{"linearAdapter": linearAdapter{}}
+	for _, name := range adapter.Names() {
+		tested[name] = adapter.Get(name)
+	}
+
+	for name, a := range tested {
+		caps := a.Capabilities()
+
+		// the static routes with the test handler
+		loadTestHandler = true
+		r := loadAdapter(a)(staticRoutes)
+		loadTestHandler = false
+		for _, route := range staticRoutes {
+			req := newRawRequest(route.method, route.path)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != route.path {
+				t.Errorf(
+					"%s: %d - %s; expected %s %s\n",
+					name, w.Code, w.Body.String(), route.method, route.path,
+				)
+			}
+		}
+		if caps.StaticOnly || !caps.Params {
+			continue
+		}
+
+		// a single route with the write handler
+		r = a.LoadSingle("GET", "/user/:name", adapter.HandleWrite)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newRawRequest("GET", "/user/gordon"))
+		if w.Code != 200 || w.Body.String() != "gordon" {
+			t.Errorf("%s: %d - %q for /user/gordon; expected \"gordon\"", name, w.Code, w.Body.String())
+		}
+
+		// all routes with the params handler
+		r = loadParams(loadAdapter(a), githubAPI)
+		for _, route := range githubAPI {
+			path, expected := paramRequest(route.path)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, newRawRequest(route.method, path))
+			if w.Code != 200 || w.Body.String() != expected {
+				t.Errorf(
+					"%s: %d - %q; expected %q for %s %s\n",
+					name, w.Code, w.Body.String(), expected, route.method, path,
+				)
+			}
+		}
+	}
+}
+
+// BenchmarkAdapters runs the benchmarks of adapterBenchmarks for all
+// registered adapters, e.g. -bench=Adapters/MyRouter_GithubAll. The versions
+// of a router registered with adapter.RegisterVersion are run next to each
+// other, e.g. Adapters/HttpRouter_GithubAll/version=new, so that
+// benchstat -col /version compares them.
+func BenchmarkAdapters(b *testing.B) {
+	var names []string
+	for _, name := range adapter.Names() {
+		router, version := adapter.SplitVersion(name)
+		if version == "" || len(names) == 0 || names[len(names)-1] != router {
+			names = append(names, router)
+		}
+	}
+
+	for _, router := range names {
+		// the pinned version first, it is the base of the comparison
+		versions := adapter.Versions(router)
+		if len(versions) == 0 {
+			versions = []string{""}
+		}
+		for _, bench := range adapterBenchmarks {
+			bench := bench
+			for _, version := range versions {
+				name, sub := router, ""
+				if version != "" {
+					name, sub = router+"@"+version, "/version="+version
+				}
+				a := adapter.Get(name)
+				if a.Capabilities().StaticOnly && !bench.static {
+					continue
+				}
+				b.Run(router+"_"+bench.scenario+sub, func(b *testing.B) {
+					// before the adapter loads the routes
+					skipShard(b)
+					bench.bench(b, a)
+				})
+			}
+		}
+	}
+}
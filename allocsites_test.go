@@ -0,0 +1,260 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var (
+	allocSitesTop      = flag.Int("allocsites", 0, "print the top `N` allocation sites per router and API")
+	allocSitesRequests = flag.Int("allocsites.requests", 100, "number of passes over the routes of an API while profiling")
+)
+
+// allocSite aggregates the allocations of one function on behalf of one
+// category of code, see allocCategory
+type allocSite struct {
+	function string
+	category string
+	bytes    int64
+	count    int64
+}
+
+// TestAllocSites serves every route of every API a fixed number of times with
+// runtime.MemProfileRate = 1 and prints, for each router, where the
+// allocations happened. Each site is attributed to the router itself, to the
+// adapter glue in routers.go (package main) or to net/http, see allocCategory.
+//
+// It only runs if the -allocsites flag is set:
+//
+//	go test -run=AllocSites -allocsites=10
+func TestAllocSites(t *testing.T) {
+	if *allocSitesTop <= 0 {
+		t.Skip("run with -allocsites=N to print the top N allocation sites")
+	}
+
+	rate := runtime.MemProfileRate
+	runtime.MemProfileRate = 1
+	defer func() { runtime.MemProfileRate = rate }()
+
+	for _, router := range routers {
+		if !isTested(router.name) {
+			continue
+		}
+		for _, api := range apis {
+			h := router.load(api.routes)
+
+			// warm up pools and lazily initialized structures
+			serveRoutes(h, api.routes, 1)
+
+			sites := profileAllocs(router.pkg, func() {
+				serveRoutes(h, api.routes, *allocSitesRequests)
+			})
+			printAllocSites(router.name, api.name, sites, *allocSitesRequests*len(api.routes))
+		}
+	}
+}
+
+// serveRoutes requests every route n times, reusing the request like
+// benchRoutes does.
+func serveRoutes(router http.Handler, routes []route, n int) {
+	w := newResponseWriter()
+	r, _ := http.NewRequest("GET", "/", nil)
+	u := r.URL
+	rq := u.RawQuery
+
+	for i := 0; i < n; i++ {
+		for _, route := range routes {
+			r.Method = route.method
+			r.RequestURI = route.path
+			u.Path = route.path
+			u.RawQuery = rq
+			router.ServeHTTP(w, r)
+			w.reset()
+		}
+	}
+}
+
+// profileAllocs returns the allocations of serveRoutes made while running f,
+// grouped by the function which allocated and its category, sorted by bytes
+// in descending order. pkg is the import path of the router package. Earlier
+// allocations, e.g. of the load or of the snapshots, can show up late in the
+// profile, so the ones of other functions are left out.
+func profileAllocs(pkg string, f func()) []allocSite {
+	// Make room for both snapshots up front, otherwise the allocation of the
+	// first one would show up in the second one.
+	n, _ := runtime.MemProfile(nil, true)
+	before := make([]runtime.MemProfileRecord, n+1000)
+	after := make([]runtime.MemProfileRecord, n+1000)
+
+	before = memProfile(before)
+	f()
+	after = memProfile(after)
+
+	prev := make(map[[32]uintptr]runtime.MemProfileRecord, len(before))
+	for _, rec := range before {
+		prev[rec.Stack0] = rec
+	}
+
+	byFunc := make(map[[2]string]*allocSite)
+	for _, rec := range after {
+		bytes, count := rec.AllocBytes, rec.AllocObjects
+		if p, ok := prev[rec.Stack0]; ok {
+			bytes -= p.AllocBytes
+			count -= p.AllocObjects
+		}
+		if count <= 0 {
+			continue
+		}
+
+		functions := stackFuncs(rec.Stack())
+		if !serving(functions) {
+			continue
+		}
+		fn, category := allocFunc(functions), allocCategory(functions, pkg)
+		site := byFunc[[2]string{fn, category}]
+		if site == nil {
+			site = &allocSite{function: fn, category: category}
+			byFunc[[2]string{fn, category}] = site
+		}
+		site.bytes += bytes
+		site.count += count
+	}
+
+	sites := make([]allocSite, 0, len(byFunc))
+	for _, site := range byFunc {
+		sites = append(sites, *site)
+	}
+	sort.Slice(sites, func(i, j int) bool {
+		if sites[i].bytes != sites[j].bytes {
+			return sites[i].bytes > sites[j].bytes
+		}
+		return sites[i].function < sites[j].function
+	})
+	return sites
+}
+
+// memProfile reads the current memory profile into p, growing it if
+// necessary. The profile is only updated by a garbage collection, so one is
+// forced first.
+func memProfile(p []runtime.MemProfileRecord) []runtime.MemProfileRecord {
+	runtime.GC()
+
+	n, ok := runtime.MemProfile(p, true)
+	for !ok {
+		p = make([]runtime.MemProfileRecord, n+50)
+		n, ok = runtime.MemProfile(p, true)
+	}
+	return p[:n]
+}
+
+// stackFuncs returns the names of the functions of the stack, starting with
+// the one which allocated.
+func stackFuncs(stk []uintptr) []string {
+	var functions []string
+	frames := runtime.CallersFrames(stk)
+	for {
+		frame, more := frames.Next()
+		functions = append(functions, frame.Function)
+		if !more {
+			return functions
+		}
+	}
+}
+
+// allocFunc returns the first function of the stack outside the runtime, which
+// is the one that caused the allocation.
+func allocFunc(functions []string) string {
+	for _, fn := range functions {
+		if !strings.HasPrefix(fn, "runtime.") && !strings.HasPrefix(fn, "internal/runtime/") {
+			return fn
+		}
+	}
+	return functions[len(functions)-1]
+}
+
+// the prefix of the functions of package main, which is its import path in the
+// test binary
+var mainPrefix = func() string {
+	name := runtime.FuncForPC(reflect.ValueOf(serveRoutes).Pointer()).Name()
+	return name[:strings.LastIndex(name, ".")+1]
+}()
+
+// serving reports whether the stack is one of serveRoutes.
+func serving(functions []string) bool {
+	for _, fn := range functions {
+		if fn == "main.serveRoutes" || fn == mainPrefix+"serveRoutes" {
+			return true
+		}
+	}
+	return false
+}
+
+// allocCategory returns on whose behalf an allocation happened: the first
+// function of the stack in the router package pkg, in package main, which is
+// the adapter glue, or in net/http decides. Helpers of the standard library,
+// e.g. strings.Split, take the category of their caller.
+func allocCategory(functions []string, pkg string) string {
+	for _, fn := range functions {
+		switch {
+		case pkg != "" && (strings.HasPrefix(fn, pkg+".") || strings.HasPrefix(fn, pkg+"/")):
+			return "router"
+		case strings.HasPrefix(fn, "main.") || strings.HasPrefix(fn, mainPrefix):
+			return "main"
+		case strings.HasPrefix(fn, "net/http."):
+			return "net/http"
+		}
+	}
+	return "other"
+}
+
+func printAllocSites(router, api string, sites []allocSite, requests int) {
+	var bytes, count int64
+	categories := make(map[string]int64)
+	for _, site := range sites {
+		bytes += site.bytes
+		count += site.count
+		categories[site.category] += site.bytes
+	}
+
+	fmt.Printf("%s %s: %d requests, %d B, %d allocs", router, api, requests, bytes, count)
+	for _, category := range []string{"router", "main", "net/http", "other"} {
+		if b := categories[category]; b > 0 {
+			fmt.Printf(", %s %d B", category, b)
+		}
+	}
+	fmt.Println()
+	for i, site := range sites {
+		if i == *allocSitesTop {
+			break
+		}
+		fmt.Printf("   %10d B %8d allocs  %-8s  %s\n", site.bytes, site.count, site.category, site.function)
+	}
+	fmt.Println()
+}
+
+func TestAllocCategory(t *testing.T) {
+	const pkg = "github.com/julienschmidt/httprouter"
+	for _, test := range []struct {
+		functions []string
+		expected  string
+	}{
+		{[]string{"runtime.mallocgc", "strings.genSplit", "strings.Split", pkg + ".(*Router).ServeHTTP", "main.serveRoutes"}, "router"},
+		{[]string{"regexp.(*Regexp).ReplaceAllString", "main.loadGorillaMux", "main.TestAllocSites"}, "main"},
+		{[]string{"strings.Fields", mainPrefix + "(*regexpRouter).ServeHTTP"}, "main"},
+		{[]string{"runtime.makemap_small", "net/http.Header.Clone", pkg + ".(*Router).ServeHTTP"}, "net/http"},
+		{[]string{"runtime.mallocgc", "strings.Repeat"}, "other"},
+	} {
+		if category := allocCategory(test.functions, pkg); category != test.expected {
+			t.Errorf("allocCategory(%q): %s; expected %s", test.functions, category, test.expected)
+		}
+	}
+	if fn := allocFunc([]string{"runtime.mallocgc", "strings.genSplit", "main.serveRoutes"}); fn != "strings.genSplit" {
+		t.Errorf("allocFunc: %s; expected strings.genSplit", fn)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nobadger
+// +build !nobadger
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+
+	"github.com/hugoluchessi/badger"
+)
+
+var _ = registerRouter(benchRouter{
+	name:       "Badger",
+	pkg:        "github.com/hugoluchessi/badger",
+	load:       loadBadger,
+	loadGroups: loadBadgerGroups,
+})
+
+// Badger
+func badgerHandle(_ http.ResponseWriter, _ *http.Request) {}
+
+func badgerHandleWrite(rw http.ResponseWriter, req *http.Request) {
+	rp := badger.GetRouteParamsFromRequest(req)
+	value, _ := rp.GetString("name")
+	io.WriteString(rw, value)
+}
+
+func badgerHandleTest(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func badgerHandleParams(names []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rp := badger.GetRouteParamsFromRequest(r)
+		for _, name := range names {
+			value, _ := rp.GetString(name)
+			io.WriteString(w, value)
+			io.WriteString(w, "\n")
+		}
+	})
+}
+
+var badgerParam = regexp.MustCompile(":([^/]*)")
+
+// badgerPath translates the parameters of a route from the :name syntax of
+// the APIs to the {name} syntax of Badger. Badger takes :name as a literal
+// segment, so until the routes were translated, the API benchmarks of Badger
+// measured requests which did not match any route. Badger results of the
+// APIs from before can not be compared with the ones from after.
+func badgerPath(path string) string {
+	return badgerParam.ReplaceAllString(path, "{$1}")
+}
+
+func loadBadger(routes []route) http.Handler {
+	mux := badger.NewMux()
+	router := mux.AddRouter("")
+
+	var h http.Handler = http.HandlerFunc(badgerHandle)
+	if loadTestHandler {
+		h = http.HandlerFunc(badgerHandleTest)
+	}
+
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = badgerHandleParams(paramNames(route.path))
+		}
+		router.Handle(route.method, badgerPath(route.path), h)
+	}
+
+	return httpMiddlewares(mux)
+}
+
+func loadBadgerSingle(method, path string, handle http.Handler) http.Handler {
+	mux := badger.NewMux()
+	router := mux.AddRouter("")
+	router.Handle(method, path, handle)
+	return mux
+}
+
+// Badger routers can not be nested, every group gets a router for its full
+// prefix.
+func loadBadgerGroups(groups []routeGroup) http.Handler {
+	mux := badger.NewMux()
+
+	var add func(prefix string, group routeGroup)
+	add = func(prefix string, group routeGroup) {
+		prefix += group.prefix
+		router := mux.AddRouter(badgerPath(prefix))
+		for _, route := range group.routes {
+			var h http.Handler = http.HandlerFunc(badgerHandle)
+			if loadTestHandler {
+				h = http.HandlerFunc(badgerHandleTest)
+			}
+			if loadParamsHandler {
+				h = badgerHandleParams(paramNames(prefix + route.path))
+			}
+			router.Handle(route.method, badgerPath(route.path), h)
+		}
+		for _, g := range group.groups {
+			add(prefix, g)
+		}
+	}
+	for _, group := range groups {
+		add("", group)
+	}
+	return mux
+}
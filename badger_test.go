@@ -0,0 +1,250 @@
+//go:build !nobadger
+// +build !nobadger
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkBadger_Param(b *testing.B) {
+	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandle))
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkBadger_Param5(b *testing.B) {
+	router := loadBadgerSingle("GET", fiveBrace, http.HandlerFunc(badgerHandle))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkBadger_Param20(b *testing.B) {
+	router := loadBadgerSingle("GET", twentyBrace, http.HandlerFunc(badgerHandle))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkBadger_ParamWrite(b *testing.B) {
+	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandleWrite))
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkBadger_Param5Write(b *testing.B) {
+	router := loadBadgerSingle("GET", fiveBrace, badgerHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkBadger_Param20Write(b *testing.B) {
+	router := loadBadgerSingle("GET", twentyBrace, badgerHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkBadger_ParamEncodedWrite(b *testing.B) {
+	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandleWrite))
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkBadger_ParamSlashWrite(b *testing.B) {
+	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandleWrite))
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkBadger_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Badger"], req)
+}
+
+// Param
+func BenchmarkBadger_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Badger"], req)
+}
+
+// Param and write all params
+func BenchmarkBadger_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadBadger, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// Loading all routes
+func BenchmarkBadger_GithubLoad(b *testing.B) {
+	benchLoad(b, loadBadger, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkBadger_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Badger"], req)
+}
+
+// One Param
+func BenchmarkBadger_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Badger"], req)
+}
+
+// Two Params
+func BenchmarkBadger_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Badger"], req)
+}
+
+// One Param and write
+func BenchmarkBadger_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadBadger, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkBadger_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadBadger, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkBadger_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Badger"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkBadger_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadBadger, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkBadger_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Badger"], req)
+}
+
+// One Param
+func BenchmarkBadger_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Badger"], req)
+}
+
+// Two Params
+func BenchmarkBadger_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Badger"], req)
+}
+
+// All Routes
+func BenchmarkBadger_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Badger"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkBadger_ParseLoad(b *testing.B) {
+	benchLoad(b, loadBadger, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkBadger_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Badger"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkBadger_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBadger, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkBadger_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Badger"], req)
+}
+
+// Double slash
+func BenchmarkBadger_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Badger"], req)
+}
+
+// Dot segment
+func BenchmarkBadger_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Badger"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkBadger_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadBadger, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkBadger_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadBadger, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkBadger_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadBadger, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkBadger_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["Badger"], req)
+}
+
+// All routes
+func BenchmarkBadger_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["Badger"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkBadger_GithubReload(b *testing.B) {
+	benchReload(b, loadBadger, githubAPI)
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Baselines
+// Trivial and naive routers, to show how far the real routers are from them:
+// BaselineDirect calls the handler without routing at all, the lower bound.
+// BaselineLinear compares the request path with the segments of every route.
+// BaselineMap looks up static paths in a map per method.
+// BaselineRegexp matches the request path with a regular expression per route.
+
+// BaselineMap only takes part in the static routes, see static_test.go
+var (
+	_ = registerRouter(benchRouter{name: "BaselineDirect", load: loadBaselineDirect})
+	_ = registerRouter(benchRouter{name: "BaselineLinear", load: loadBaselineLinear})
+	_ = registerRouter(benchRouter{name: "BaselineRegexp", load: loadBaselineRegexp})
+)
+
+type baselineParam struct {
+	name  string
+	value string
+}
+
+type baselineParams []baselineParam
+
+// ByName returns the value of the parameter with the name.
+func (ps baselineParams) ByName(name string) string {
+	for _, p := range ps {
+		if p.name == name {
+			return p.value
+		}
+	}
+	return ""
+}
+
+type baselineHandle func(http.ResponseWriter, *http.Request, baselineParams)
+
+func baselineHandler(_ http.ResponseWriter, _ *http.Request, _ baselineParams) {}
+
+func baselineHandlerWrite(w http.ResponseWriter, _ *http.Request, ps baselineParams) {
+	io.WriteString(w, ps.ByName("name"))
+}
+
+func baselineHandlerTest(w http.ResponseWriter, r *http.Request, _ baselineParams) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func baselineHandlerParams(names []string) baselineHandle {
+	return func(w http.ResponseWriter, _ *http.Request, ps baselineParams) {
+		for _, name := range names {
+			io.WriteString(w, ps.ByName(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+// BaselineDirect
+func loadBaselineDirect(routes []route) http.Handler {
+	var h http.HandlerFunc = httpHandlerFunc
+	if loadTestHandler {
+		h = httpHandlerFuncTest
+	}
+	return httpMiddlewares(h)
+}
+
+func loadBaselineDirectSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	return handler
+}
+
+// BaselineLinear
+type linearRoute struct {
+	method   string
+	segments []string // without the leading empty segment
+	handle   baselineHandle
+}
+
+// match compares the path with the segments of the route and appends the
+// parameters to ps.
+func (l *linearRoute) match(path string, ps baselineParams) (baselineParams, bool) {
+	for _, segment := range l.segments {
+		if path == "" || path[0] != '/' {
+			return ps, false
+		}
+		path = path[1:]
+		end := strings.IndexByte(path, '/')
+		if end < 0 {
+			end = len(path)
+		}
+
+		if strings.HasPrefix(segment, ":") {
+			if end == 0 {
+				return ps, false
+			}
+			ps = append(ps, baselineParam{segment[1:], path[:end]})
+		} else if segment != path[:end] {
+			return ps, false
+		}
+		path = path[end:]
+	}
+	return ps, path == ""
+}
+
+type linearRouter struct {
+	routes []linearRoute
+}
+
+func (l *linearRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for i := range l.routes {
+		route := &l.routes[i]
+		if route.method != r.Method {
+			continue
+		}
+		if ps, ok := route.match(r.URL.Path, nil); ok {
+			route.handle(w, r, ps)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+func (l *linearRouter) handle(method, path string, handle baselineHandle) {
+	l.routes = append(l.routes, linearRoute{
+		method:   method,
+		segments: strings.Split(path, "/")[1:],
+		handle:   handle,
+	})
+}
+
+func loadBaselineLinear(routes []route) http.Handler {
+	var h baselineHandle = baselineHandler
+	if loadTestHandler {
+		h = baselineHandlerTest
+	}
+
+	router := new(linearRouter)
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = baselineHandlerParams(paramNames(route.path))
+		}
+		router.handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadBaselineLinearSingle(method, path string, handle baselineHandle) http.Handler {
+	router := new(linearRouter)
+	router.handle(method, path, handle)
+	return router
+}
+
+// BaselineMap
+type mapRouter map[string]map[string]http.HandlerFunc
+
+func (m mapRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h, ok := m[r.Method][r.URL.Path]; ok {
+		h(w, r)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func (m mapRouter) handle(method, path string, handler http.HandlerFunc) {
+	if strings.Contains(path, "/:") {
+		panic("BaselineMap can only match static routes: " + path)
+	}
+	if m[method] == nil {
+		m[method] = make(map[string]http.HandlerFunc)
+	}
+	m[method][path] = handler
+}
+
+func loadBaselineMap(routes []route) http.Handler {
+	var h http.HandlerFunc = httpHandlerFunc
+	if loadTestHandler {
+		h = httpHandlerFuncTest
+	}
+
+	router := make(mapRouter)
+	for _, route := range routes {
+		router.handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadBaselineMapSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	router := make(mapRouter)
+	router.handle(method, path, handler)
+	return router
+}
+
+// BaselineRegexp
+type regexpRoute struct {
+	method string
+	re     *regexp.Regexp
+	names  []string
+	handle baselineHandle
+}
+
+type regexpRouter struct {
+	routes []regexpRoute
+}
+
+func (rr *regexpRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for i := range rr.routes {
+		route := &rr.routes[i]
+		if route.method != r.Method {
+			continue
+		}
+		match := route.re.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		var ps baselineParams
+		for j, name := range route.names {
+			ps = append(ps, baselineParam{name, match[j+1]})
+		}
+		route.handle(w, r, ps)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func (rr *regexpRouter) handle(method, path string, handle baselineHandle) {
+	re := regexp.MustCompile(":[^/]*")
+	rr.routes = append(rr.routes, regexpRoute{
+		method: method,
+		re:     regexp.MustCompile("^" + re.ReplaceAllString(regexp.QuoteMeta(path), "([^/]+)") + "$"),
+		names:  paramNames(path),
+		handle: handle,
+	})
+}
+
+func loadBaselineRegexp(routes []route) http.Handler {
+	var h baselineHandle = baselineHandler
+	if loadTestHandler {
+		h = baselineHandlerTest
+	}
+
+	router := new(regexpRouter)
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = baselineHandlerParams(paramNames(route.path))
+		}
+		router.handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadBaselineRegexpSingle(method, path string, handle baselineHandle) http.Handler {
+	router := new(regexpRouter)
+	router.handle(method, path, handle)
+	return router
+}
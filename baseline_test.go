@@ -0,0 +1,480 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkBaselineDirect_Param(b *testing.B) {
+	router := loadBaselineDirectSingle("GET", "/user/:name", httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineLinear_Param(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", "/user/:name", baselineHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_Param(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", "/user/:name", baselineHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkBaselineDirect_Param5(b *testing.B) {
+	router := loadBaselineDirectSingle("GET", fiveColon, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineLinear_Param5(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", fiveColon, baselineHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_Param5(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", fiveColon, baselineHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkBaselineDirect_Param20(b *testing.B) {
+	router := loadBaselineDirectSingle("GET", twentyColon, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineLinear_Param20(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", twentyColon, baselineHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_Param20(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", twentyColon, baselineHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkBaselineLinear_ParamWrite(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_ParamWrite(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkBaselineLinear_Param5Write(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", fiveColon, baselineHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_Param5Write(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", fiveColon, baselineHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkBaselineLinear_Param20Write(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", twentyColon, baselineHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_Param20Write(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", twentyColon, baselineHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkBaselineLinear_ParamEncodedWrite(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_ParamEncodedWrite(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkBaselineLinear_ParamSlashWrite(b *testing.B) {
+	router := loadBaselineLinearSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkBaselineRegexp_ParamSlashWrite(b *testing.B) {
+	router := loadBaselineRegexpSingle("GET", "/user/:name", baselineHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkBaselineDirect_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["BaselineRegexp"], req)
+}
+
+// Param
+func BenchmarkBaselineDirect_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["BaselineRegexp"], req)
+}
+
+// Param and write all params
+func BenchmarkBaselineLinear_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadBaselineLinear, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkBaselineRegexp_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadBaselineRegexp, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkBaselineDirect_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["BaselineDirect"], githubAPI)
+}
+func BenchmarkBaselineLinear_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["BaselineLinear"], githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["BaselineRegexp"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkBaselineDirect_GithubLoad(b *testing.B) {
+	benchLoad(b, loadBaselineDirect, githubAPI)
+}
+func BenchmarkBaselineLinear_GithubLoad(b *testing.B) {
+	benchLoad(b, loadBaselineLinear, githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubLoad(b *testing.B) {
+	benchLoad(b, loadBaselineRegexp, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkBaselineDirect_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["BaselineRegexp"], req)
+}
+
+// One Param
+func BenchmarkBaselineDirect_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["BaselineRegexp"], req)
+}
+
+// Two Params
+func BenchmarkBaselineDirect_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["BaselineRegexp"], req)
+}
+
+// One Param and write
+func BenchmarkBaselineLinear_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadBaselineLinear, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkBaselineRegexp_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadBaselineRegexp, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkBaselineLinear_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadBaselineLinear, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkBaselineRegexp_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadBaselineRegexp, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkBaselineDirect_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["BaselineDirect"], gplusAPI)
+}
+func BenchmarkBaselineLinear_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["BaselineLinear"], gplusAPI)
+}
+func BenchmarkBaselineRegexp_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["BaselineRegexp"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkBaselineDirect_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadBaselineDirect, gplusAPI)
+}
+func BenchmarkBaselineLinear_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadBaselineLinear, gplusAPI)
+}
+func BenchmarkBaselineRegexp_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadBaselineRegexp, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkBaselineDirect_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["BaselineRegexp"], req)
+}
+
+// One Param
+func BenchmarkBaselineDirect_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["BaselineRegexp"], req)
+}
+
+// Two Params
+func BenchmarkBaselineDirect_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["BaselineDirect"], req)
+}
+func BenchmarkBaselineLinear_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["BaselineRegexp"], req)
+}
+
+// All Routes
+func BenchmarkBaselineDirect_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["BaselineDirect"], parseAPI)
+}
+func BenchmarkBaselineLinear_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["BaselineLinear"], parseAPI)
+}
+func BenchmarkBaselineRegexp_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["BaselineRegexp"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkBaselineDirect_ParseLoad(b *testing.B) {
+	benchLoad(b, loadBaselineDirect, parseAPI)
+}
+func BenchmarkBaselineLinear_ParseLoad(b *testing.B) {
+	benchLoad(b, loadBaselineLinear, parseAPI)
+}
+func BenchmarkBaselineRegexp_ParseLoad(b *testing.B) {
+	benchLoad(b, loadBaselineRegexp, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkBaselineDirect_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["BaselineDirect"], staticRoutes)
+}
+func BenchmarkBaselineLinear_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["BaselineLinear"], staticRoutes)
+}
+func BenchmarkBaselineRegexp_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["BaselineRegexp"], staticRoutes)
+}
+func BenchmarkBaselineMap_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["BaselineMap"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkBaselineDirect_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBaselineDirect, staticRoutes)
+}
+func BenchmarkBaselineLinear_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBaselineLinear, staticRoutes)
+}
+func BenchmarkBaselineRegexp_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBaselineRegexp, staticRoutes)
+}
+func BenchmarkBaselineMap_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBaselineMap, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkBaselineLinear_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["BaselineRegexp"], req)
+}
+
+// Double slash
+func BenchmarkBaselineLinear_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["BaselineRegexp"], req)
+}
+
+// Dot segment
+func BenchmarkBaselineLinear_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["BaselineLinear"], req)
+}
+func BenchmarkBaselineRegexp_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["BaselineRegexp"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkBaselineDirect_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineDirect, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineLinear_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineLinear, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineRegexp, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkBaselineDirect_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineDirect, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineLinear_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineLinear, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineRegexp, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkBaselineDirect_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineDirect, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineLinear_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineLinear, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadBaselineRegexp, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkBaselineDirect_GithubReload(b *testing.B) {
+	benchReload(b, loadBaselineDirect, githubAPI)
+}
+func BenchmarkBaselineLinear_GithubReload(b *testing.B) {
+	benchReload(b, loadBaselineLinear, githubAPI)
+}
+func BenchmarkBaselineRegexp_GithubReload(b *testing.B) {
+	benchReload(b, loadBaselineRegexp, githubAPI)
+}
@@ -0,0 +1,81 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nobear
+// +build !nobear
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+
+	"github.com/ursiform/bear"
+)
+
+var _ = registerRouter(benchRouter{name: "Bear", pkg: "github.com/ursiform/bear", load: loadBear})
+
+// bear
+func bearHandler(_ http.ResponseWriter, _ *http.Request, _ *bear.Context) {}
+
+func bearHandlerWrite(w http.ResponseWriter, _ *http.Request, ctx *bear.Context) {
+	io.WriteString(w, ctx.Params["name"])
+}
+
+func bearHandlerTest(w http.ResponseWriter, r *http.Request, _ *bear.Context) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func bearMiddleware(_ http.ResponseWriter, _ *http.Request, ctx *bear.Context) {
+	middlewareCalls++
+	ctx.Next()
+}
+
+func bearHandlerParams(names []string) bear.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, ctx *bear.Context) {
+		for _, name := range names {
+			io.WriteString(w, ctx.Params[name])
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadBear(routes []route) http.Handler {
+	var h bear.HandlerFunc = bearHandler
+	if loadTestHandler {
+		h = bearHandlerTest
+	}
+
+	router := bear.New()
+	re := regexp.MustCompile(":([^/]*)")
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = bearHandlerParams(paramNames(route.path))
+		}
+		handlers := make([]interface{}, 0, loadMiddlewares+1)
+		for i := 0; i < loadMiddlewares; i++ {
+			handlers = append(handlers, bearMiddleware)
+		}
+		handlers = append(handlers, h)
+		switch route.method {
+		case "GET", "POST", "PUT", "PATCH", "DELETE":
+			router.On(route.method, re.ReplaceAllString(route.path, "{$1}"), handlers...)
+		default:
+			panic("Unknown HTTP method: " + route.method)
+		}
+	}
+	return router
+}
+
+func loadBearSingle(method string, path string, handler bear.HandlerFunc) http.Handler {
+	router := bear.New()
+	switch method {
+	case "GET", "POST", "PUT", "PATCH", "DELETE":
+		router.On(method, path, handler)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+	return router
+}
@@ -0,0 +1,242 @@
+//go:build !nobear
+// +build !nobear
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkBear_Param(b *testing.B) {
+	router := loadBearSingle("GET", "/user/{name}", bearHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkBear_Param5(b *testing.B) {
+	router := loadBearSingle("GET", fiveBrace, bearHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkBear_Param20(b *testing.B) {
+	router := loadBearSingle("GET", twentyBrace, bearHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkBear_ParamWrite(b *testing.B) {
+	router := loadBearSingle("GET", "/user/{name}", bearHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkBear_Param5Write(b *testing.B) {
+	router := loadBearSingle("GET", fiveBrace, bearHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkBear_Param20Write(b *testing.B) {
+	router := loadBearSingle("GET", twentyBrace, bearHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkBear_ParamEncodedWrite(b *testing.B) {
+	router := loadBearSingle("GET", "/user/{name}", bearHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkBear_ParamSlashWrite(b *testing.B) {
+	router := loadBearSingle("GET", "/user/{name}", bearHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkBear_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Bear"], req)
+}
+
+// Param
+func BenchmarkBear_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Bear"], req)
+}
+
+// Param and write all params
+func BenchmarkBear_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadBear, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkBear_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Bear"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkBear_GithubLoad(b *testing.B) {
+	benchLoad(b, loadBear, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkBear_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Bear"], req)
+}
+
+// One Param
+func BenchmarkBear_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Bear"], req)
+}
+
+// Two Params
+func BenchmarkBear_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Bear"], req)
+}
+
+// One Param and write
+func BenchmarkBear_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadBear, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkBear_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadBear, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkBear_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Bear"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkBear_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadBear, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkBear_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Bear"], req)
+}
+
+// One Param
+func BenchmarkBear_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Bear"], req)
+}
+
+// Two Params
+func BenchmarkBear_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Bear"], req)
+}
+
+// All Routes
+func BenchmarkBear_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Bear"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkBear_ParseLoad(b *testing.B) {
+	benchLoad(b, loadBear, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkBear_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Bear"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkBear_StaticLoad(b *testing.B) {
+	benchLoad(b, loadBear, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkBear_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Bear"], req)
+}
+
+// Double slash
+func BenchmarkBear_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Bear"], req)
+}
+
+// Dot segment
+func BenchmarkBear_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Bear"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkBear_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadBear, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkBear_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadBear, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkBear_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadBear, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkBear_GithubReload(b *testing.B) {
+	benchReload(b, loadBear, githubAPI)
+}
@@ -5,27 +5,210 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"hash/fnv"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 var benchRe *regexp.Regexp
 
+var writerMode = flag.String("writer", "discard", "`mode` of the ResponseWriter of the benchmarks: discard, header or record")
+
+var refRouter = flag.String("ref", "BaselineLinear", "`router` the results are normalized to by cmd/report")
+
+// printReference prints the reference router as a configuration line and
+// warns if there is no such router, then the results cannot be normalized.
+func printReference() {
+	fmt.Println("reference:", *refRouter)
+	if findRouter(*refRouter) == nil {
+		println("#Reference Router", *refRouter, "does not exist, the results cannot be normalized")
+		println()
+	}
+}
+
+// benchWithReference returns the -bench pattern extended by the benchmarks of
+// the reference router, in the same scenarios if the pattern names them, e.g.
+// Gin_GithubAll|^BenchmarkBaselineLinear_(GithubAll), so that the results of
+// every run can be normalized.
+func benchWithReference(bench string) string {
+	ref := "^Benchmark" + regexp.QuoteMeta(*refRouter) + "_"
+	if parts := strings.SplitN(bench, "_", 2); len(parts) == 2 && !strings.ContainsAny(bench, "/|") {
+		ref += "(" + parts[1] + ")"
+	}
+	return bench + "|" + ref
+}
+
+var shardFlag = flag.String("shard", "", "run only the routers of shard `i/n` of n shards, e.g. 2/4")
+
+// the shard of the routers to run and the number of shards, see inShard
+var shard, shards = 1, 1
+
+// setShard checks the -shard flag and prints it as a configuration line, so
+// that cmd/report -merge knows the shards it merges.
+func setShard() error {
+	if *shardFlag == "" {
+		return nil
+	}
+	if err := parseShard(*shardFlag); err != nil {
+		return err
+	}
+	fmt.Println("shard:", *shardFlag)
+	return nil
+}
+
+// parseShard sets the shard to run from a value of the -shard flag.
+func parseShard(value string) error {
+	var i, n int
+	if _, err := fmt.Sscanf(value, "%d/%d", &i, &n); err != nil || n < 1 || i < 1 || i > n {
+		return fmt.Errorf("invalid -shard %q, expected i/n with 1 <= i <= n", value)
+	}
+	shard, shards = i, n
+	return nil
+}
+
+var shardArgs sync.Once
+
+// inShard reports whether a router is in the shard to run. The routers are
+// loaded before the flags are parsed, so like isTested it reads the -shard
+// flag from os.Args; an invalid value is reported by setShard.
+func inShard(router string) bool {
+	shardArgs.Do(func() {
+		for i, arg := range os.Args {
+			switch {
+			case strings.HasPrefix(arg, "-shard="):
+				parseShard(arg[7:])
+			case arg == "-shard" && i+1 < len(os.Args):
+				parseShard(os.Args[i+1])
+			}
+		}
+	})
+	return shardOf(router, shards) == shard
+}
+
+// shardOf returns the shard of a router, which only depends on its name. All
+// benchmarks of a router, including the versions registered with
+// adapter.RegisterVersion, are in the same shard, so that a shard only loads
+// its own routers and the versions are compared in the same process.
+func shardOf(router string, n int) int {
+	h := fnv.New32a()
+	h.Write([]byte(router))
+	return int(h.Sum32()%uint32(n)) + 1
+}
+
+// benchmarkRouter returns the router of a benchmark, e.g. Gin for
+// BenchmarkGin_GithubAll and HttpRouter for
+// BenchmarkAdapters/HttpRouter_GithubAll/version=new.
+func benchmarkRouter(name string) string {
+	name = strings.TrimPrefix(name, "Benchmark")
+	name = strings.TrimPrefix(name, "Adapters/")
+	return strings.SplitN(name, "_", 2)[0]
+}
+
+// skipShard skips the benchmark if its router is not in the shard to run. It
+// is called by all bench functions, the routers of the APIs are not even
+// loaded then, see calcMem.
+func skipShard(b *testing.B) {
+	if router := benchmarkRouter(b.Name()); !inShard(router) {
+		b.Skipf("%s is in shard %d/%d", router, shardOf(router, shards), shards)
+	}
+}
+
+func TestShardOf(t *testing.T) {
+	for _, name := range []string{"Gin", "HttpRouter", "BaselineLinear", "MyRouter"} {
+		i := shardOf(name, 3)
+		if i < 1 || i > 3 || shardOf(name, 3) != i {
+			t.Errorf("shardOf(%s, 3): %d; expected the same shard from 1 to 3", name, i)
+		}
+	}
+
+	for name, expected := range map[string]string{
+		"BenchmarkGin_GithubAll":                             "Gin",
+		"BenchmarkGin_GPlusAll":                              "Gin",
+		"BenchmarkHttpRouter_Param":                          "HttpRouter",
+		"BenchmarkAdapters/MyRouter_ParseAll":                "MyRouter",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=old": "HttpRouter",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=new": "HttpRouter",
+	} {
+		if router := benchmarkRouter(name); router != expected {
+			t.Errorf("benchmarkRouter(%s): %s; expected %s", name, router, expected)
+		}
+	}
+}
+
+func TestBenchWithReference(t *testing.T) {
+	for bench, expected := range map[string][]string{
+		"Gin":                 {"BenchmarkGin_GithubAll", "BenchmarkBaselineLinear_GithubAll", "BenchmarkBaselineLinear_Param"},
+		"Gin_GithubAll":       {"BenchmarkGin_GithubAll", "BenchmarkBaselineLinear_GithubAllMiddleware1"},
+		"Adapters/HttpRouter": {"BenchmarkBaselineLinear_GithubAll"},
+	} {
+		re := regexp.MustCompile(benchWithReference(bench))
+		for _, name := range expected {
+			if !re.MatchString(name) {
+				t.Errorf("-bench=%s does not run %s", bench, name)
+			}
+		}
+	}
+	if re := regexp.MustCompile(benchWithReference("Gin_GithubAll")); re.MatchString("BenchmarkBaselineLinear_Param") {
+		t.Error("-bench=Gin_GithubAll runs BenchmarkBaselineLinear_Param")
+	}
+}
+
+// newResponseWriter returns the ResponseWriter for the -writer mode: discard
+// everything, keep the headers in a reused map or keep the whole response.
+func newResponseWriter() benchResponseWriter {
+	switch *writerMode {
+	case "discard":
+		return new(mockResponseWriter)
+	case "header":
+		return new(headerResponseWriter)
+	case "record":
+		return new(recordingResponseWriter)
+	}
+	panic("unknown writer mode " + *writerMode)
+}
+
+// resetWriter reports whether the writer of newResponseWriter has to be reset
+// after every request. The timed loops skip the no-op reset of the default
+// writer, so that its results stay comparable to those from before -writer.
+func resetWriter() bool {
+	return *writerMode != "discard"
+}
+
 func isTested(name string) bool {
 	if benchRe == nil {
 		// Get -test.bench flag value (not accessible via flag package)
-		bench := ""
-		for _, arg := range os.Args {
-			if strings.HasPrefix(arg, "-test.bench=") {
+		bench, ref := "", *refRouter
+		for i, arg := range os.Args {
+			switch {
+			case strings.HasPrefix(arg, "-test.bench="):
+				bench = arg[12:]
+				// the adapters run as sub-benchmarks of BenchmarkAdapters,
+				// e.g. -bench=Adapters/HttpRouter for HttpRouter@new
+				if parts := strings.SplitN(bench, "/", 2); len(parts) == 2 && regexp.MustCompile(parts[0]).MatchString("Adapters") {
+					bench = parts[1]
+				}
 				// ignore the benchmark name after an underscore
-				bench = strings.SplitN(arg[12:], "_", 2)[0]
-				break
+				bench = strings.SplitN(bench, "_", 2)[0]
+			// the flags are not parsed yet when the routers are loaded
+			case strings.HasPrefix(arg, "-ref="):
+				ref = arg[5:]
+			case arg == "-ref" && i+1 < len(os.Args):
+				ref = os.Args[i+1]
 			}
 		}
+		// the reference router runs along, see benchWithReference
+		if bench != "" {
+			bench = "(" + bench + ")|^" + regexp.QuoteMeta(ref) + "$"
+		}
 
 		// Compile RegExp to match Benchmark names
 		var err error
@@ -38,7 +221,7 @@ func isTested(name string) bool {
 }
 
 func calcMem(name string, load func()) {
-	if !isTested(name) {
+	if !isTested(name) || !inShard(name) {
 		return
 	}
 
@@ -48,18 +231,23 @@ func calcMem(name string, load func()) {
 	runtime.GC()
 	runtime.ReadMemStats(m)
 	before := m.HeapAlloc
+	mallocs := m.Mallocs
 
+	start := time.Now()
 	load()
+	elapsed := time.Since(start)
 
 	// after
 	runtime.GC()
 	runtime.ReadMemStats(m)
 	after := m.HeapAlloc
-	println("   "+name+":", after-before, "Bytes")
+	println("   "+name+":", after-before, "Bytes,", m.Mallocs-mallocs, "allocs,", elapsed.String())
 }
 
 func benchRequest(b *testing.B, router http.Handler, r *http.Request) {
-	w := new(mockResponseWriter)
+	skipShard(b)
+	w := newResponseWriter()
+	reset := resetWriter()
 	u := r.URL
 	rq := u.RawQuery
 	r.RequestURI = u.RequestURI()
@@ -70,461 +258,122 @@ func benchRequest(b *testing.B, router http.Handler, r *http.Request) {
 	for i := 0; i < b.N; i++ {
 		u.RawQuery = rq
 		router.ServeHTTP(w, r)
-	}
-}
-
-func benchRoutes(b *testing.B, router http.Handler, routes []route) {
-	w := new(mockResponseWriter)
-	r, _ := http.NewRequest("GET", "/", nil)
-	u := r.URL
-	rq := u.RawQuery
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		for _, route := range routes {
-			r.Method = route.method
-			r.RequestURI = route.path
-			u.Path = route.path
-			u.RawQuery = rq
-			router.ServeHTTP(w, r)
+		if reset {
+			w.reset()
 		}
 	}
 }
 
-// Micro Benchmarks
-
-// Route with Param (no write)
-func BenchmarkAce_Param(b *testing.B) {
-	router := loadAceSingle("GET", "/user/:name", aceHandle)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBadger_Param(b *testing.B) {
-	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandle))
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBear_Param(b *testing.B) {
-	router := loadBearSingle("GET", "/user/{name}", bearHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkDenco_Param(b *testing.B) {
-	router := loadDencoSingle("GET", "/user/:name", dencoHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// variantRequest is a request whose path the router cleans, redirects or looks
+// up case-insensitively. Routers like HttpRouter and Gin write the fixed path
+// into the URL of the request while doing so, serve restores it first.
+type variantRequest struct {
+	r          *http.Request
+	url        url.URL
+	requestURI string
 }
-func BenchmarkEcho_Param(b *testing.B) {
-	router := loadEchoSingle("GET", "/user/:name", echoHandler)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_Param(b *testing.B) {
-	router := loadGinSingle("GET", "/user/:name", ginHandle)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGoJsonRest_Param(b *testing.B) {
-	router := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+func newVariantRequest(r *http.Request) *variantRequest {
+	if r.RequestURI == "" {
+		r.RequestURI = r.URL.RequestURI()
+	}
+	return &variantRequest{r: r, url: *r.URL, requestURI: r.RequestURI}
 }
-func BenchmarkGorillaMux_Param(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", "/user/{name}", httpHandlerFunc)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+func (v *variantRequest) serve(router http.Handler, w http.ResponseWriter) {
+	*v.r.URL = v.url
+	v.r.RequestURI = v.requestURI
+	router.ServeHTTP(w, v.r)
 }
-func BenchmarkHttpRouter_Param(b *testing.B) {
-	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandle)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpTreeMux_Param(b *testing.B) {
-	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandler)
+// benchVariant is benchRequest for the path variants and case variants, see
+// variantRequest. Otherwise only the first request would be a variant, all
+// others would be an ordinary match of the fixed path.
+func benchVariant(b *testing.B, router http.Handler, r *http.Request) {
+	skipShard(b)
+	w := newResponseWriter()
+	reset := resetWriter()
+	v := newVariantRequest(r)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkLARS_Param(b *testing.B) {
-	router := loadLARSSingle("GET", "/user/:name", larsHandler)
+	b.ReportAllocs()
+	b.ResetTimer()
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+	for i := 0; i < b.N; i++ {
+		v.serve(router, w)
+		if reset {
+			w.reset()
+		}
+	}
 }
-func BenchmarkMartini_Param(b *testing.B) {
-	router := loadMartiniSingle("GET", "/user/:name", martiniHandler)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// loadRouters loads the routes into every router, printing the memory each
+// router takes for them, see calcMem.
+func loadRouters(loaded map[string]http.Handler, routes []route) {
+	for _, router := range routers {
+		calcMem(router.name, func() {
+			loaded[router.name] = router.load(routes)
+		})
+	}
 }
 
-func BenchmarkPossum_Param(b *testing.B) {
-	router := loadPossumSingle("GET", "/user/:name", possumHandler)
+// benchLoad benchmarks loading the routes, i.e. building the routing
+// structure, which happens again on every reload of the routes.
+func benchLoad(b *testing.B, load func(routes []route) http.Handler, routes []route) {
+	skipShard(b)
+	b.ReportAllocs()
+	b.ResetTimer()
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+	for i := 0; i < b.N; i++ {
+		load(routes)
+	}
 }
-func BenchmarkR2router_Param(b *testing.B) {
-	router := loadR2routerSingle("GET", "/user/:name", r2routerHandler)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// loadParams loads the routes with the params handlers, which write all
+// parameters of the matched route, see paramNames
+func loadParams(load func(routes []route) http.Handler, routes []route) http.Handler {
+	loadParamsHandler = true
+	router := load(routes)
+	loadParamsHandler = false
+	return router
 }
 
-func BenchmarkRivet_Param(b *testing.B) {
-	router := loadRivetSingle("GET", "/user/:name", rivetHandler)
+func benchRoutes(b *testing.B, router http.Handler, routes []route) {
+	skipShard(b)
+	w := newResponseWriter()
+	reset := resetWriter()
+	r, _ := http.NewRequest("GET", "/", nil)
+	u := r.URL
+	rq := u.RawQuery
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkVulcan_Param(b *testing.B) {
-	router := loadVulcanSingle("GET", "/user/:name", vulcanHandler)
+	b.ReportAllocs()
+	b.ResetTimer()
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+	for i := 0; i < b.N; i++ {
+		for _, route := range routes {
+			r.Method = route.method
+			r.RequestURI = route.path
+			u.Path = route.path
+			u.RawQuery = rq
+			router.ServeHTTP(w, r)
+			if reset {
+				w.reset()
+			}
+		}
+	}
 }
 
-// func BenchmarkZeus_Param(b *testing.B) {
-// 	router := loadZeusSingle("GET", "/user/:name", http.HandlerFunc(httpHandlerFunc))
-
-// 	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-// 	benchRequest(b, router, r)
-// }
+// Micro Benchmarks, in the _test.go file of each router
 
-// Route with 5 Params (no write)
+// Route with 5 Params
 const fiveColon = "/:a/:b/:c/:d/:e"
 const fiveBrace = "/{a}/{b}/{c}/{d}/{e}"
 const fiveRoute = "/test/test/test/test/test"
 
-func BenchmarkAce_Param5(b *testing.B) {
-	router := loadAceSingle("GET", fiveColon, aceHandle)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBadger_Param5(b *testing.B) {
-	router := loadBadgerSingle("GET", fiveBrace, http.HandlerFunc(badgerHandle))
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBear_Param5(b *testing.B) {
-	router := loadBearSingle("GET", fiveBrace, bearHandler)
+var fiveNames = paramNames(fiveColon)
 
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkDenco_Param5(b *testing.B) {
-	router := loadDencoSingle("GET", fiveColon, dencoHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkEcho_Param5(b *testing.B) {
-	router := loadEchoSingle("GET", fiveColon, echoHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_Param5(b *testing.B) {
-	router := loadGinSingle("GET", fiveColon, ginHandle)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGoJsonRest_Param5(b *testing.B) {
-	handler := loadGoJsonRestSingle("GET", fiveColon, goJsonRestHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, handler, r)
-}
-func BenchmarkGorillaMux_Param5(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", fiveBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpRouter_Param5(b *testing.B) {
-	router := loadHttpRouterSingle("GET", fiveColon, httpRouterHandle)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpTreeMux_Param5(b *testing.B) {
-	router := loadHttpTreeMuxSingle("GET", fiveColon, httpTreeMuxHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkLARS_Param5(b *testing.B) {
-	router := loadLARSSingle("GET", fiveColon, larsHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkMartini_Param5(b *testing.B) {
-	router := loadMartiniSingle("GET", fiveColon, martiniHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkPossum_Param5(b *testing.B) {
-	router := loadPossumSingle("GET", fiveColon, possumHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkR2router_Param5(b *testing.B) {
-	router := loadR2routerSingle("GET", fiveColon, r2routerHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkRivet_Param5(b *testing.B) {
-	router := loadRivetSingle("GET", fiveColon, rivetHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkVulcan_Param5(b *testing.B) {
-	router := loadVulcanSingle("GET", fiveColon, vulcanHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-// func BenchmarkZeus_Param5(b *testing.B) {
-// 	router := loadZeusSingle("GET", fiveColon, http.HandlerFunc(httpHandlerFunc))
-
-// 	r, _ := http.NewRequest("GET", fiveRoute, nil)
-// 	benchRequest(b, router, r)
-// }
-
-// Route with 20 Params (no write)
+// Route with 20 Params
 const twentyColon = "/:a/:b/:c/:d/:e/:f/:g/:h/:i/:j/:k/:l/:m/:n/:o/:p/:q/:r/:s/:t"
 const twentyBrace = "/{a}/{b}/{c}/{d}/{e}/{f}/{g}/{h}/{i}/{j}/{k}/{l}/{m}/{n}/{o}/{p}/{q}/{r}/{s}/{t}"
 const twentyRoute = "/a/b/c/d/e/f/g/h/i/j/k/l/m/n/o/p/q/r/s/t"
 
-func BenchmarkAce_Param20(b *testing.B) {
-	router := loadAceSingle("GET", twentyColon, aceHandle)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBadger_Param20(b *testing.B) {
-	router := loadBadgerSingle("GET", twentyBrace, http.HandlerFunc(badgerHandle))
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBear_Param20(b *testing.B) {
-	router := loadBearSingle("GET", twentyBrace, bearHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkDenco_Param20(b *testing.B) {
-	router := loadDencoSingle("GET", twentyColon, dencoHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkEcho_Param20(b *testing.B) {
-	router := loadEchoSingle("GET", twentyColon, echoHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_Param20(b *testing.B) {
-	router := loadGinSingle("GET", twentyColon, ginHandle)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGoJsonRest_Param20(b *testing.B) {
-	handler := loadGoJsonRestSingle("GET", twentyColon, goJsonRestHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, handler, r)
-}
-func BenchmarkGorillaMux_Param20(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", twentyBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpRouter_Param20(b *testing.B) {
-	router := loadHttpRouterSingle("GET", twentyColon, httpRouterHandle)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpTreeMux_Param20(b *testing.B) {
-	router := loadHttpTreeMuxSingle("GET", twentyColon, httpTreeMuxHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkLARS_Param20(b *testing.B) {
-	router := loadLARSSingle("GET", twentyColon, larsHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkMartini_Param20(b *testing.B) {
-	router := loadMartiniSingle("GET", twentyColon, martiniHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkPossum_Param20(b *testing.B) {
-	router := loadPossumSingle("GET", twentyColon, possumHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkR2router_Param20(b *testing.B) {
-	router := loadR2routerSingle("GET", twentyColon, r2routerHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkRivet_Param20(b *testing.B) {
-	router := loadRivetSingle("GET", twentyColon, rivetHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkVulcan_Param20(b *testing.B) {
-	router := loadVulcanSingle("GET", twentyColon, vulcanHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-// func BenchmarkZeus_Param20(b *testing.B) {
-// 	router := loadZeusSingle("GET", twentyColon, http.HandlerFunc(httpHandlerFunc))
-
-// 	r, _ := http.NewRequest("GET", twentyRoute, nil)
-// 	benchRequest(b, router, r)
-// }
-
-// Route with Param and write
-func BenchmarkAce_ParamWrite(b *testing.B) {
-	router := loadAceSingle("GET", "/user/:name", aceHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBadger_ParamWrite(b *testing.B) {
-	router := loadBadgerSingle("GET", "/user/{name}", http.HandlerFunc(badgerHandleWrite))
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkBear_ParamWrite(b *testing.B) {
-	router := loadBearSingle("GET", "/user/{name}", bearHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkDenco_ParamWrite(b *testing.B) {
-	router := loadDencoSingle("GET", "/user/:name", dencoHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkEcho_ParamWrite(b *testing.B) {
-	router := loadEchoSingle("GET", "/user/:name", echoHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_ParamWrite(b *testing.B) {
-	router := loadGinSingle("GET", "/user/:name", ginHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGoJsonRest_ParamWrite(b *testing.B) {
-	handler := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, handler, r)
-}
-func BenchmarkGorillaMux_ParamWrite(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", "/user/{name}", gorillaHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpRouter_ParamWrite(b *testing.B) {
-	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkHttpTreeMux_ParamWrite(b *testing.B) {
-	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkLARS_ParamWrite(b *testing.B) {
-	router := loadLARSSingle("GET", "/user/:name", larsHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkMartini_ParamWrite(b *testing.B) {
-	router := loadMartiniSingle("GET", "/user/:name", martiniHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkPossum_ParamWrite(b *testing.B) {
-	router := loadPossumSingle("GET", "/user/:name", possumHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkR2router_ParamWrite(b *testing.B) {
-	router := loadR2routerSingle("GET", "/user/:name", r2routerHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkRivet_ParamWrite(b *testing.B) {
-	router := loadRivetSingle("GET", "/user/:name", rivetHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkVulcan_ParamWrite(b *testing.B) {
-	router := loadVulcanSingle("GET", "/user/:name", vulcanHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-// func BenchmarkZeus_ParamWrite(b *testing.B) {
-// 	router := loadZeusSingle("GET", "/user/:name", zeusHandlerWrite)
-
-// 	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-// 	benchRequest(b, router, r)
-// }
+var twentyNames = paramNames(twentyColon)
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// routers which can be configured to look up paths case-insensitively, see
+// loadCaseInsensitive
+var caseInsensitiveRouters = map[string]bool{
+	"Gin":        true,
+	"HttpRouter": true,
+}
+
+// caseVariant returns the path of a route with the first letter of every
+// static segment in upper case, like /Users/gordon/Repos for
+// /users/gordon/repos of the route /users/:user/repos.
+func caseVariant(routePath, path string) string {
+	static := strings.Split(routePath, "/")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !strings.HasPrefix(static[i], ":") {
+			segments[i] = strings.ToUpper(segment[:1]) + segment[1:]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// loadCaseInsensitiveRouter loads the routes with the router configured to
+// look up paths case-insensitively.
+func loadCaseInsensitiveRouter(load func(routes []route) http.Handler, routes []route) http.Handler {
+	loadCaseInsensitive = true
+	router := load(routes)
+	loadCaseInsensitive = false
+	return router
+}
+
+func init() {
+	const example = "/Users/gordon/Repos"
+
+	features = append(features, feature{
+		name: example,
+		check: func(router string, load func(routes []route) http.Handler) string {
+			if _, ok := routersWithoutParams[router]; ok {
+				return "-"
+			}
+			h := loadParams(load, githubAPI)
+			return featureResult(h, newRawRequest("GET", example))
+		},
+	})
+	features = append(features, feature{
+		name: example + " (case-insensitive)",
+		check: func(router string, load func(routes []route) http.Handler) string {
+			if _, ok := routersWithoutParams[router]; ok || !caseInsensitiveRouters[router] {
+				return "-"
+			}
+			loadCaseInsensitive = true
+			h := loadParams(load, githubAPI)
+			loadCaseInsensitive = false
+			return featureResult(h, newRawRequest("GET", example))
+		},
+	})
+}
+
+// TestCaseVariants sends a case variant of a concrete path of every route.
+// Routers may match it, redirect to the path with the right case or respond
+// with 404. Routers configured to look up paths case-insensitively must not
+// respond with 404.
+func TestCaseVariants(t *testing.T) {
+	for _, router := range routers {
+		if _, ok := routersWithoutParams[router.name]; ok {
+			continue
+		}
+
+		loadCaseInsensitive = caseInsensitiveRouters[router.name]
+		for _, api := range apis {
+			r := loadParams(router.load, api.routes)
+
+			for _, route := range api.routes {
+				path, expected := paramRequest(route.path)
+				variant := caseVariant(route.path, path)
+				if variant == path {
+					continue
+				}
+
+				req := newRawRequest(route.method, variant)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				switch w.Code {
+				case 200:
+					if w.Body.String() != expected {
+						t.Errorf(
+							"%s in API %s: %s %s matched with parameters %q; expected %q for %s\n",
+							router.name, api.name, req.Method, variant, w.Body.String(), expected, route.path,
+						)
+					}
+				case 301, 302, 307, 308:
+					location, err := url.Parse(w.Header().Get("Location"))
+					if err != nil || location.Path != path {
+						t.Errorf(
+							"%s in API %s: %s %s redirected to %q; expected %s\n",
+							router.name, api.name, req.Method, variant, w.Header().Get("Location"), path,
+						)
+					}
+				case 404, 405:
+					if loadCaseInsensitive {
+						t.Errorf(
+							"%s in API %s: %s %s responded with %d although case-insensitive; expected %s\n",
+							router.name, api.name, req.Method, variant, w.Code, path,
+						)
+					}
+				default:
+					t.Errorf(
+						"%s in API %s: %s %s responded with %d\n",
+						router.name, api.name, req.Method, variant, w.Code,
+					)
+				}
+			}
+		}
+	}
+	loadCaseInsensitive = false
+}
@@ -0,0 +1,235 @@
+// Command candidate sets up the side-by-side benchmark of two versions of a
+// router: the pinned version and a candidate version from a local checkout.
+//
+// Go cannot compile two versions of the same module into one binary, so the
+// checkout is made available under the import path candidate/<module>, and a
+// copy of the adapter package of the router is compiled against it. Both
+// versions are registered, e.g. as HttpRouter@old and HttpRouter@new:
+//
+//	go run ./cmd/candidate -name HttpRouter -adapter adapter/httprouter -dir ../httprouter
+//	go test -tags external -bench=Adapters/HttpRouter -count=10 > versions.txt
+//	go run ./cmd/report versions.txt
+//
+// It is run in the root of the suite and writes the copy to
+// external/<adapter>, which git ignores. If the suite is built in module mode,
+// it adds the candidate module to go.mod.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// import path of the suite
+const suite = "github.com/julienschmidt/go-http-routing-benchmark"
+
+var (
+	name       = flag.String("name", "", "`name` of the router, e.g. HttpRouter")
+	adapterDir = flag.String("adapter", "", "`directory` of the adapter package of the router, e.g. adapter/httprouter")
+	dir        = flag.String("dir", "", "`directory` of the local checkout of the candidate version")
+	module     = flag.String("module", "", "module `path` of the router, read from the go.mod of the checkout by default")
+	oldVersion = flag.String("old", "old", "`version` name of the pinned version")
+	newVersion = flag.String("new", "new", "`version` name of the candidate version")
+	out        = flag.String("out", "external", "`directory` of the package external")
+)
+
+func main() {
+	flag.Parse()
+	if *name == "" || *adapterDir == "" || *dir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "candidate:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	checkout, err := filepath.Abs(*dir)
+	if err != nil {
+		return err
+	}
+	if *module == "" {
+		if *module, err = modulePath(checkout); err != nil {
+			return err
+		}
+	}
+	alias := "candidate/" + *module
+
+	pkgDir := filepath.Join(*out, filepath.Base(*adapterDir))
+	pkgName, err := copyAdapter(*adapterDir, pkgDir, *module, alias)
+	if err != nil {
+		return err
+	}
+	if err := writeRegister(pkgDir, pkgName); err != nil {
+		return err
+	}
+	if err := writeImport(pkgDir); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat("go.mod"); err == nil {
+		cmd := exec.Command("go", "mod", "edit", "-require="+alias+"@v0.0.0", "-replace="+alias+"="+checkout)
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("no go.mod, link the checkout into the GOPATH:\n\tln -s %s $GOPATH/src/%s\n", checkout, alias)
+	}
+
+	warnSelfImports(checkout, *module)
+	fmt.Printf("registered %s@%s and %s@%s, run:\n\tgo test -tags external -bench=Adapters/%s > versions.txt\n\tgo run ./cmd/report -delta %s,%s versions.txt\n",
+		*name, *oldVersion, *name, *newVersion, *name, *oldVersion, *newVersion)
+	return nil
+}
+
+// modulePath reads the module path from the go.mod in dir.
+func modulePath(dir string) (string, error) {
+	f, err := os.Open(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("%v, set -module", err)
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if fields := strings.Fields(s.Text()); len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	return "", fmt.Errorf("no module path in %s, set -module", f.Name())
+}
+
+// copyAdapter copies the adapter package from src to dst, replacing the
+// module path with alias in all string literals, which are the import of the
+// router package and its Pkg. It returns the name of the package.
+func copyAdapter(src, dst, module, alias string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(src, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return "", err
+	}
+
+	var pkgName string
+	replaced := false
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return "", err
+		}
+		pkgName = f.Name.Name
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil || (value != module && !strings.HasPrefix(value, module+"/")) {
+				return true
+			}
+			lit.Value = strconv.Quote(alias + value[len(module):])
+			replaced = true
+			return true
+		})
+
+		var buf bytes.Buffer
+		buf.WriteString("// Code generated by candidate. DO NOT EDIT.\n\n")
+		if err := format.Node(&buf, fset, f); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filepath.Join(dst, filepath.Base(file)), buf.Bytes(), 0644); err != nil {
+			return "", err
+		}
+	}
+	if !replaced {
+		return "", fmt.Errorf("the adapter in %s does not import %s", src, module)
+	}
+	return pkgName, nil
+}
+
+// writeRegister writes the file registering the pinned and the candidate
+// version into the copy of the adapter package.
+func writeRegister(pkgDir, pkgName string) error {
+	src := fmt.Sprintf(`// Code generated by candidate. DO NOT EDIT.
+
+package %s
+
+import (
+	%q
+	pinned %q
+)
+
+func init() {
+	adapter.RegisterVersion(%q, %q, pinned.Pkg, pinned.New())
+	adapter.RegisterVersion(%q, %q, Pkg, New())
+}
+`, pkgName, suite+"/adapter", path.Join(suite, filepath.ToSlash(*adapterDir)),
+		*name, *oldVersion, *name, *newVersion)
+
+	b, err := format.Source([]byte(src))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(pkgDir, "register_candidate.go"), b, 0644)
+}
+
+// writeImport writes the file of the package external importing the copy of
+// the adapter package, next to the files of the other adapters.
+func writeImport(pkgDir string) error {
+	src := fmt.Sprintf("// Code generated by candidate. DO NOT EDIT.\n\npackage external\n\nimport _ %q\n",
+		path.Join(suite, filepath.ToSlash(pkgDir)))
+	file := filepath.Join(*out, "candidate_"+filepath.Base(pkgDir)+".go")
+	return os.WriteFile(file, []byte(src), 0644)
+}
+
+// warnSelfImports warns about packages of the checkout importing other
+// packages of the module: they are not part of the candidate, the imports
+// still resolve to the pinned version.
+func warnSelfImports(checkout, module string) {
+	filepath.Walk(checkout, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			switch info.Name() {
+			case ".git", "testdata", "vendor":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil
+		}
+		for _, imp := range f.Imports {
+			if p, _ := strconv.Unquote(imp.Path.Value); strings.HasPrefix(p, module+"/") {
+				fmt.Fprintf(os.Stderr, "candidate: warning: %s imports %s of the pinned version\n", file, p)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,442 @@
+// Command report turns the output of the benchmarks into one table per
+// scenario, e.g. GithubAll, with the time of every router both absolute and
+// normalized to the reference router:
+//
+//	go test -bench=. > laptop.txt
+//	go run ./cmd/report laptop.txt ci.txt
+//
+// Every file is a result set of one machine. The absolute times of the result
+// sets are reported next to each other, the normalized times, which do not
+// depend on the machine, are merged into one column.
+//
+// Routers benchmarked in two versions, e.g. HttpRouter@old and HttpRouter@new
+// (see the command candidate), get a table of the change of their times from
+// one version to the other, see -delta.
+//
+// With -merge all files are merged into one result set, e.g. the shards of one
+// run (see -shard of the benchmarks). Files from environments which differ in
+// anything but the load are refused: the versions, the machine and the
+// settings have to be the same.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ref   = flag.String("ref", "", "`router` the times are normalized to, the reference of the results by default")
+	merge = flag.Bool("merge", false, "merge all files into one result set, e.g. the shards of a run")
+	delta = flag.String("delta", "old,new", "`versions` the change of the times is reported between, as base,compared")
+)
+
+// configuration keys which may differ between the files of a merge
+var mergeIgnored = map[string]bool{
+	"loadavg":        true,
+	"calibration-cv": true,
+	"shard":          true,
+}
+
+// resultSet is the results of one machine.
+type resultSet struct {
+	name   string
+	config map[string]string
+
+	// ns/op by scenario and router, one per run
+	results map[string]map[string][]float64
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: report [-ref router] [-merge] [-delta old,new] results.txt...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var sets []*resultSet
+	for _, file := range flag.Args() {
+		set, err := readResults(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "report:", err)
+			os.Exit(1)
+		}
+		sets = append(sets, set)
+	}
+	versions := strings.Split(*delta, ",")
+	if len(versions) != 2 {
+		fmt.Fprintln(os.Stderr, "report: -delta takes two versions, e.g. old,new")
+		os.Exit(2)
+	}
+	if *merge {
+		set, err := mergeSets(sets)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "report:", err)
+			os.Exit(1)
+		}
+		sets = []*resultSet{set}
+	}
+	printReport(sets)
+	printDelta(sets, versions[0], versions[1])
+}
+
+// readResults reads a result set from the output of the benchmarks.
+func readResults(file string) (*resultSet, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	set := &resultSet{
+		name:    file,
+		config:  make(map[string]string),
+		results: make(map[string]map[string][]float64),
+	}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if key, value, ok := configLine(line); ok {
+			set.config[key] = value
+			continue
+		}
+		if router, scenario, ns, ok := resultLine(line); ok {
+			if set.results[scenario] == nil {
+				set.results[scenario] = make(map[string][]float64)
+			}
+			set.results[scenario][router] = append(set.results[scenario][router], ns)
+		}
+	}
+	return set, s.Err()
+}
+
+// mergeSets merges result sets from the same environment into one. It refuses
+// to merge a shard twice and warns about missing shards.
+func mergeSets(sets []*resultSet) (*resultSet, error) {
+	merged := &resultSet{
+		config:  sets[0].config,
+		results: make(map[string]map[string][]float64),
+	}
+	var names []string
+	shards := make(map[int]string)
+	n := 0
+	for _, set := range sets {
+		if err := compatible(sets[0], set); err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if name == set.name {
+				return nil, fmt.Errorf("cannot merge %s twice", name)
+			}
+		}
+		var i int
+		if _, err := fmt.Sscanf(set.config["shard"], "%d/%d", &i, &n); err == nil {
+			if other, dup := shards[i]; dup {
+				return nil, fmt.Errorf("cannot merge %s and %s, both are shard %d/%d", other, set.name, i, n)
+			}
+			shards[i] = set.name
+		}
+
+		names = append(names, set.name)
+		for scenario, routers := range set.results {
+			if merged.results[scenario] == nil {
+				merged.results[scenario] = make(map[string][]float64)
+			}
+			for router, times := range routers {
+				merged.results[scenario][router] = append(merged.results[scenario][router], times...)
+			}
+		}
+	}
+	merged.name = strings.Join(names, ", ")
+
+	for i := 1; i <= n; i++ {
+		if _, ok := shards[i]; !ok {
+			fmt.Fprintf(os.Stderr, "report: warning: shard %d/%d is missing\n", i, n)
+		}
+	}
+	return merged, nil
+}
+
+// compatible returns an error if the result sets come from different
+// environments, see mergeIgnored.
+func compatible(a, b *resultSet) error {
+	var keys []string
+	for key := range a.config {
+		keys = append(keys, key)
+	}
+	for key := range b.config {
+		if _, ok := a.config[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if mergeIgnored[key] {
+			continue
+		}
+		if a.config[key] != b.config[key] {
+			return fmt.Errorf("cannot merge %s and %s, %s differs: %q and %q",
+				a.name, b.name, key, a.config[key], b.config[key])
+		}
+	}
+
+	// the shards of a run have the same number of shards
+	var i, n, m int
+	fmt.Sscanf(a.config["shard"], "%d/%d", &i, &n)
+	fmt.Sscanf(b.config["shard"], "%d/%d", &i, &m)
+	if n != m {
+		return fmt.Errorf("cannot merge %s and %s, the number of shards differs: %d and %d", a.name, b.name, n, m)
+	}
+	return nil
+}
+
+// configLine parses a configuration line of the benchmark format, e.g.
+// "go: go1.22.1". The key starts with a lower case letter and contains neither
+// spaces nor upper case letters.
+func configLine(line string) (key, value string, ok bool) {
+	i := strings.Index(line, ":")
+	if i <= 0 || line[0] < 'a' || line[0] > 'z' {
+		return "", "", false
+	}
+	key = line[:i]
+	if strings.IndexFunc(key, func(r rune) bool { return r == ' ' || r == '\t' || ('A' <= r && r <= 'Z') }) >= 0 {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(line[i+1:]), true
+}
+
+// resultLine parses the router, the scenario and the ns/op of a result line,
+// e.g. "BenchmarkGin_GithubAll 30000 50425 ns/op". The versions of the
+// adapters are part of the router, e.g. the router of
+// BenchmarkAdapters/HttpRouter_GithubAll/version=new is HttpRouter@new.
+func resultLine(line string) (router, scenario string, ns float64, ok bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return "", "", 0, false
+	}
+	for i := 2; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "ns/op" {
+			ns, _ = strconv.ParseFloat(fields[i], 64)
+			ok = true
+		}
+	}
+	if !ok {
+		return "", "", 0, false
+	}
+
+	name := strings.TrimPrefix(fields[0], "Benchmark")
+	// the GOMAXPROCS suffix, e.g. -8
+	if i := strings.LastIndex(name, "-"); i >= 0 {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			name = name[:i]
+		}
+	}
+	name = strings.TrimPrefix(name, "Adapters/")
+	version := ""
+	if i := strings.Index(name, "/version="); i >= 0 {
+		name, version = name[:i], name[i+len("/version="):]
+	}
+
+	router, scenario = name, ""
+	if i := strings.Index(name, "_"); i >= 0 {
+		router, scenario = name[:i], name[i+1:]
+	}
+	if version != "" {
+		router += "@" + version
+	}
+	return router, scenario, ns, true
+}
+
+// median returns the median of the values.
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// reference returns the name of the reference router of a result set.
+func (set *resultSet) reference() string {
+	if *ref != "" {
+		return *ref
+	}
+	return set.config["reference"]
+}
+
+// normalized returns the median time of the router in the scenario divided by
+// the one of the reference router, or false if one of them is missing.
+func (set *resultSet) normalized(scenario, router string) (float64, bool) {
+	times, refTimes := set.results[scenario][router], set.results[scenario][set.reference()]
+	if len(times) == 0 || len(refTimes) == 0 {
+		return 0, false
+	}
+	return median(times) / median(refTimes), true
+}
+
+// printReport prints the result sets and a table per scenario.
+func printReport(sets []*resultSet) {
+	for i, set := range sets {
+		fmt.Printf("#%d %s: %s, %s cores, reference %s", i+1, set.name,
+			set.config["cpu-model"], set.config["cpu-cores"], set.reference())
+		if calibration := set.results[""]["Calibration"]; len(calibration) > 0 {
+			fmt.Printf(", calibration %.0f ns/op", median(calibration))
+		}
+		fmt.Println()
+	}
+
+	scenarios := make(map[string]map[string]bool)
+	for _, set := range sets {
+		for scenario, routers := range set.results {
+			if scenario == "" {
+				continue
+			}
+			if scenarios[scenario] == nil {
+				scenarios[scenario] = make(map[string]bool)
+			}
+			for router := range routers {
+				scenarios[scenario][router] = true
+			}
+		}
+	}
+
+	for _, scenario := range sortedKeys(scenarios) {
+		fmt.Printf("\n### %s\n\n| Router |", scenario)
+		for i := range sets {
+			fmt.Printf(" ns/op #%d |", i+1)
+		}
+		fmt.Print(" x reference |\n|:--|")
+		fmt.Print(strings.Repeat("--:|", len(sets)+1), "\n")
+
+		var routers []string
+		for router := range scenarios[scenario] {
+			routers = append(routers, router)
+		}
+		sort.Strings(routers)
+		for _, router := range routers {
+			fmt.Printf("| %s |", router)
+			var ratios []float64
+			for _, set := range sets {
+				if times := set.results[scenario][router]; len(times) > 0 {
+					fmt.Printf(" %.0f |", median(times))
+				} else {
+					fmt.Print(" - |")
+				}
+				if ratio, ok := set.normalized(scenario, router); ok {
+					ratios = append(ratios, ratio)
+				}
+			}
+			if len(ratios) > 0 {
+				fmt.Printf(" %.3f |\n", median(ratios))
+			} else {
+				fmt.Print(" - |\n")
+			}
+		}
+	}
+}
+
+// delta returns the relative change of the median time of the router in the
+// scenario from version a to version b, e.g. -0.1 if HttpRouter@new takes 10%
+// less time than HttpRouter@old, or false if one of them is missing.
+func (set *resultSet) delta(scenario, router, a, b string) (float64, bool) {
+	timesA, timesB := set.results[scenario][router+"@"+a], set.results[scenario][router+"@"+b]
+	if len(timesA) == 0 || len(timesB) == 0 {
+		return 0, false
+	}
+	return median(timesB)/median(timesA) - 1, true
+}
+
+// deltaRow is the change of the time of a router from one version to the
+// other in a scenario.
+type deltaRow struct {
+	scenario, router string
+	deltas           []float64 // per result set, NaN if one version is missing
+}
+
+// deltaRows returns the rows of the routers benchmarked in versions a and b,
+// sorted by scenario and router.
+func deltaRows(sets []*resultSet, a, b string) []deltaRow {
+	var rows []deltaRow
+	seen := make(map[[2]string]bool)
+	for _, set := range sets {
+		for scenario, routers := range set.results {
+			for name := range routers {
+				router := strings.TrimSuffix(name, "@"+a)
+				if router == name || seen[[2]string{scenario, router}] {
+					continue
+				}
+				seen[[2]string{scenario, router}] = true
+
+				r := deltaRow{scenario: scenario, router: router}
+				for _, set := range sets {
+					d, ok := set.delta(scenario, router, a, b)
+					if !ok {
+						d = math.NaN()
+					}
+					r.deltas = append(r.deltas, d)
+				}
+				rows = append(rows, r)
+			}
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].scenario != rows[j].scenario {
+			return rows[i].scenario < rows[j].scenario
+		}
+		return rows[i].router < rows[j].router
+	})
+	return rows
+}
+
+// printDelta prints a table of the change from version a to version b of
+// every router benchmarked in both versions, per result set and merged.
+func printDelta(sets []*resultSet, a, b string) {
+	rows := deltaRows(sets, a, b)
+	if len(rows) == 0 {
+		return
+	}
+
+	fmt.Printf("\n### %s to %s\n\n| Scenario | Router |", a, b)
+	for i := range sets {
+		fmt.Printf(" delta #%d |", i+1)
+	}
+	fmt.Print(" delta |\n|:--|:--|")
+	fmt.Print(strings.Repeat("--:|", len(sets)+1), "\n")
+	for _, r := range rows {
+		fmt.Printf("| %s | %s |", r.scenario, r.router)
+		var deltas []float64
+		for _, d := range r.deltas {
+			if math.IsNaN(d) {
+				fmt.Print(" - |")
+				continue
+			}
+			fmt.Printf(" %+.1f%% |", 100*d)
+			deltas = append(deltas, d)
+		}
+		if len(deltas) > 0 {
+			fmt.Printf(" %+.1f%% |\n", 100*median(deltas))
+		} else {
+			fmt.Print(" - |\n")
+		}
+	}
+}
+
+func sortedKeys(m map[string]map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeResults writes the output of a shard of a benchmark run and reads it
+// back as a result set.
+func writeResults(t *testing.T, name, goVersion, shard string, results ...string) *resultSet {
+	t.Helper()
+	lines := append([]string{
+		"go: " + goVersion,
+		"cpu-model: Test CPU",
+		"reference: BaselineLinear",
+		"loadavg: 0.10 0.10 0.10",
+		"shard: " + shard,
+	}, results...)
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	set, err := readResults(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
+
+func TestMergeSets(t *testing.T) {
+	shard1 := writeResults(t, "shard1.txt", "go1.22.1", "1/2",
+		"BenchmarkBaselineLinear_GithubAll-8 \t 1000 \t 100000 ns/op",
+		"BenchmarkHttpRouter_GithubAll-8 \t 1000 \t 20000 ns/op",
+	)
+	shard2 := writeResults(t, "shard2.txt", "go1.22.1", "2/2",
+		"BenchmarkGin_GithubAll-8 \t 1000 \t 25000 ns/op",
+	)
+	other := writeResults(t, "other.txt", "go1.21.0", "2/2",
+		"BenchmarkGin_GithubAll-8 \t 1000 \t 30000 ns/op",
+	)
+
+	merged, err := mergeSets([]*resultSet{shard1, shard2})
+	if err != nil {
+		t.Fatalf("mergeSets of the shards of a run: %v", err)
+	}
+	for _, router := range []string{"BaselineLinear", "HttpRouter", "Gin"} {
+		if times := merged.results["GithubAll"][router]; len(times) != 1 {
+			t.Errorf("merged results of %s: %v; expected one", router, times)
+		}
+	}
+	if ratio, ok := merged.normalized("GithubAll", "Gin"); !ok || ratio != 0.25 {
+		t.Errorf("normalized Gin across shards: %g, %t; expected 0.25", ratio, ok)
+	}
+
+	if err := compatible(shard1, other); err == nil {
+		t.Error("compatible of different Go versions: no error")
+	}
+	if _, err := mergeSets([]*resultSet{shard1, shard2, other}); err == nil {
+		t.Error("mergeSets with a set of another Go version: no error")
+	}
+	if _, err := mergeSets([]*resultSet{shard1, shard1}); err == nil {
+		t.Error("mergeSets of the same file twice: no error")
+	}
+	again := writeResults(t, "again.txt", "go1.22.1", "1/2")
+	if _, err := mergeSets([]*resultSet{shard1, again, shard2}); err == nil {
+		t.Error("mergeSets of two files of shard 1/2: no error")
+	}
+}
+
+func TestDeltaRows(t *testing.T) {
+	laptop := writeResults(t, "laptop.txt", "go1.22.1", "1/1",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=old-8 \t 1000 \t 20000 ns/op",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=new-8 \t 1000 \t 18000 ns/op",
+		"BenchmarkAdapters/HttpRouter_GPlusAll/version=old-8 \t 1000 \t 1000 ns/op",
+		"BenchmarkGin_GithubAll-8 \t 1000 \t 25000 ns/op",
+	)
+	ci := writeResults(t, "ci.txt", "go1.22.1", "1/1",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=old-8 \t 1000 \t 40000 ns/op",
+		"BenchmarkAdapters/HttpRouter_GithubAll/version=new-8 \t 1000 \t 44000 ns/op",
+	)
+
+	rows := deltaRows([]*resultSet{laptop, ci}, "old", "new")
+	if len(rows) != 2 {
+		t.Fatalf("deltaRows: %v; expected GithubAll and GPlusAll of HttpRouter", rows)
+	}
+	gplus, github := rows[0], rows[1]
+	if github.scenario != "GithubAll" || github.router != "HttpRouter" {
+		t.Errorf("second row: %s %s; expected GithubAll HttpRouter", github.scenario, github.router)
+	}
+	for i, expected := range []float64{-0.1, 0.1} {
+		if d := github.deltas[i]; math.Abs(d-expected) > 1e-9 {
+			t.Errorf("delta of GithubAll in set #%d: %g; expected %g", i+1, d, expected)
+		}
+	}
+	for i, d := range gplus.deltas {
+		if !math.IsNaN(d) {
+			t.Errorf("delta of GPlusAll without a new version in set #%d: %g; expected none", i+1, d)
+		}
+	}
+
+	if rows := deltaRows([]*resultSet{laptop}, "new", "old"); len(rows) != 1 || math.Abs(rows[0].deltas[0]-(20000.0/18000-1)) > 1e-9 {
+		t.Errorf("deltaRows from new to old: %v; expected the inverse change of GithubAll", rows)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// The GitHub API, where the repository routes have a second version which is
+// only served for requests accepting the v3 media type. All other requests
+// fall through to the unconstrained routes.
+var headerAPI = constrainRepos(constrainedRoute{
+	header: "Accept",
+	value:  "application/vnd.github.v3+json",
+})
+
+// The GitHub API, where the repository routes have a second version which is
+// only served for requests with the query parameter per_page=100.
+var queryAPI = constrainRepos(constrainedRoute{
+	query: "per_page",
+	value: "100",
+})
+
+// constrainRepos returns the GitHub API with a constrained copy of every
+// repository route in front, which routers trying the routes in order must
+// see first.
+func constrainRepos(c constrainedRoute) []constrainedRoute {
+	var routes []constrainedRoute
+	for _, r := range githubAPI {
+		if strings.HasPrefix(r.path, "/repos/") {
+			c.route = r
+			routes = append(routes, c)
+		}
+	}
+	for _, r := range githubAPI {
+		routes = append(routes, constrainedRoute{route: r})
+	}
+	return routes
+}
+
+// the header and the query API loaded into every router which can match them,
+// see benchRouter.loadConstrained, by name. go-json-rest can only do it in
+// middlewares (IfMiddleware).
+var (
+	headerRouters = make(map[string]http.Handler)
+	queryRouters  = make(map[string]http.Handler)
+)
+
+func init() {
+	println("#Header Routes:", len(headerAPI))
+
+	for _, router := range routers {
+		if router.loadConstrained != nil {
+			calcMem(router.name, func() {
+				headerRouters[router.name] = router.loadConstrained(headerAPI)
+			})
+		}
+	}
+
+	println()
+
+	println("#Query Routes:", len(queryAPI))
+
+	for _, router := range routers {
+		if router.constrainQuery {
+			calcMem(router.name, func() {
+				queryRouters[router.name] = router.loadConstrained(queryAPI)
+			})
+		}
+	}
+
+	println()
+
+	features = append(features, feature{
+		name: "Header / query routing",
+		check: func(router string, _ func(routes []route) http.Handler) string {
+			switch r := findRouter(router); {
+			case r == nil || r.loadConstrained == nil:
+				return "unsupported"
+			case r.constrainQuery:
+				return "header, query"
+			}
+			return "header"
+		},
+	})
+}
+
+// constrainedRequest returns a request for the path, with the header or
+// query parameter of the constrained route set.
+func constrainedRequest(route constrainedRoute) *http.Request {
+	uri := route.path
+	if route.query != "" {
+		uri += "?" + route.query + "=" + route.value
+	}
+	req := newRawRequest(route.method, uri)
+	if route.header != "" {
+		req.Header.Set(route.header, route.value)
+	}
+	return req
+}
+
+func TestConstrainedRouters(t *testing.T) {
+	loadTestHandler = true
+
+	for _, router := range routers {
+		if router.loadConstrained == nil {
+			continue
+		}
+		for _, api := range []struct {
+			name   string
+			routes []constrainedRoute
+		}{
+			{"Header", headerAPI},
+			{"Query", queryAPI},
+		} {
+			if api.name == "Query" && !router.constrainQuery {
+				continue
+			}
+			r := router.loadConstrained(api.routes)
+
+			for _, route := range api.routes {
+				// with the constraint, and without it, which must fall through
+				// to the unconstrained route
+				for _, c := range []constrainedRoute{route, {route: route.route}} {
+					req := constrainedRequest(c)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					expected := c.constraint() + " " + req.RequestURI
+					if w.Code != 200 || w.Body.String() != expected {
+						t.Errorf(
+							"%s in API %s: %d - %s; expected %s for %s %s (%s)\n",
+							router.name, api.name, w.Code, w.Body.String(), expected, c.method, req.RequestURI, req.Header,
+						)
+					}
+				}
+			}
+		}
+	}
+
+	loadTestHandler = false
+}
+
+// benchConstrainedRoutes requests all routes like benchRoutes, with the
+// header or query parameter of the route set.
+func benchConstrainedRoutes(b *testing.B, router http.Handler, routes []constrainedRoute) {
+	skipShard(b)
+	w := newResponseWriter()
+	reset := resetWriter()
+	r, _ := http.NewRequest("GET", "/", nil)
+	u := r.URL
+
+	headers := make([]http.Header, len(routes))
+	queries := make([]string, len(routes))
+	for i, route := range routes {
+		headers[i] = http.Header{}
+		if route.header != "" {
+			headers[i].Set(route.header, route.value)
+		}
+		if route.query != "" {
+			queries[i] = route.query + "=" + route.value
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, route := range routes {
+			r.Method = route.method
+			r.Header = headers[j]
+			r.RequestURI = route.path
+			u.Path = route.path
+			u.RawQuery = queries[j]
+			router.ServeHTTP(w, r)
+			if reset {
+				w.reset()
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nodenco
+// +build !nodenco
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/naoina/denco"
+)
+
+var _ = registerRouter(benchRouter{name: "Denco", pkg: "github.com/naoina/denco", load: loadDenco})
+
+// Denco
+func dencoHandler(w http.ResponseWriter, r *http.Request, params denco.Params) {}
+
+func dencoHandlerWrite(w http.ResponseWriter, r *http.Request, params denco.Params) {
+	io.WriteString(w, params.Get("name"))
+}
+
+func dencoHandlerTest(w http.ResponseWriter, r *http.Request, params denco.Params) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func dencoHandlerParams(names []string) denco.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params denco.Params) {
+		for _, name := range names {
+			io.WriteString(w, params.Get(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadDenco(routes []route) http.Handler {
+	var h denco.HandlerFunc = dencoHandler
+	if loadTestHandler {
+		h = dencoHandlerTest
+	}
+
+	mux := denco.NewMux()
+	handlers := make([]denco.Handler, 0, len(routes))
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = dencoHandlerParams(paramNames(route.path))
+		}
+		handler := mux.Handler(route.method, route.path, h)
+		handlers = append(handlers, handler)
+	}
+	handler, err := mux.Build(handlers)
+	if err != nil {
+		panic(err)
+	}
+	return httpMiddlewares(handler)
+}
+
+func loadDencoSingle(method, path string, h denco.HandlerFunc) http.Handler {
+	mux := denco.NewMux()
+	handler, err := mux.Build([]denco.Handler{mux.Handler(method, path, h)})
+	if err != nil {
+		panic(err)
+	}
+	return handler
+}
@@ -0,0 +1,242 @@
+//go:build !nodenco
+// +build !nodenco
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkDenco_Param(b *testing.B) {
+	router := loadDencoSingle("GET", "/user/:name", dencoHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkDenco_Param5(b *testing.B) {
+	router := loadDencoSingle("GET", fiveColon, dencoHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkDenco_Param20(b *testing.B) {
+	router := loadDencoSingle("GET", twentyColon, dencoHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkDenco_ParamWrite(b *testing.B) {
+	router := loadDencoSingle("GET", "/user/:name", dencoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkDenco_Param5Write(b *testing.B) {
+	router := loadDencoSingle("GET", fiveColon, dencoHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkDenco_Param20Write(b *testing.B) {
+	router := loadDencoSingle("GET", twentyColon, dencoHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkDenco_ParamEncodedWrite(b *testing.B) {
+	router := loadDencoSingle("GET", "/user/:name", dencoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkDenco_ParamSlashWrite(b *testing.B) {
+	router := loadDencoSingle("GET", "/user/:name", dencoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkDenco_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Denco"], req)
+}
+
+// Param
+func BenchmarkDenco_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Denco"], req)
+}
+
+// Param and write all params
+func BenchmarkDenco_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadDenco, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkDenco_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Denco"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkDenco_GithubLoad(b *testing.B) {
+	benchLoad(b, loadDenco, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkDenco_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Denco"], req)
+}
+
+// One Param
+func BenchmarkDenco_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Denco"], req)
+}
+
+// Two Params
+func BenchmarkDenco_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Denco"], req)
+}
+
+// One Param and write
+func BenchmarkDenco_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadDenco, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkDenco_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadDenco, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkDenco_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Denco"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkDenco_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadDenco, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkDenco_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Denco"], req)
+}
+
+// One Param
+func BenchmarkDenco_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Denco"], req)
+}
+
+// Two Params
+func BenchmarkDenco_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Denco"], req)
+}
+
+// All Routes
+func BenchmarkDenco_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Denco"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkDenco_ParseLoad(b *testing.B) {
+	benchLoad(b, loadDenco, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkDenco_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Denco"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkDenco_StaticLoad(b *testing.B) {
+	benchLoad(b, loadDenco, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkDenco_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Denco"], req)
+}
+
+// Double slash
+func BenchmarkDenco_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Denco"], req)
+}
+
+// Dot segment
+func BenchmarkDenco_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Denco"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkDenco_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadDenco, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkDenco_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadDenco, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkDenco_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadDenco, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkDenco_GithubReload(b *testing.B) {
+	benchReload(b, loadDenco, githubAPI)
+}
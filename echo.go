@@ -0,0 +1,151 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !noecho
+// +build !noecho
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo"
+)
+
+var _ = registerRouter(benchRouter{
+	name:       "Echo",
+	pkg:        "github.com/labstack/echo",
+	load:       loadEcho,
+	loadGroups: loadEchoGroups,
+})
+
+// Echo
+func echoHandler(c echo.Context) error {
+	return nil
+}
+
+func echoHandlerWrite(c echo.Context) error {
+	io.WriteString(c.Response(), c.Param("name"))
+	return nil
+}
+
+func echoHandlerTest(c echo.Context) error {
+	io.WriteString(c.Response(), c.Request().RequestURI)
+	return nil
+}
+
+func echoMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		middlewareCalls++
+		return next(c)
+	}
+}
+
+func echoHandlerParams(names []string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		for _, name := range names {
+			io.WriteString(c.Response(), c.Param(name))
+			io.WriteString(c.Response(), "\n")
+		}
+		return nil
+	}
+}
+
+func loadEcho(routes []route) http.Handler {
+	var h echo.HandlerFunc = echoHandler
+	if loadTestHandler {
+		h = echoHandlerTest
+	}
+
+	e := echo.New()
+	for i := 0; i < loadMiddlewares; i++ {
+		e.Use(echoMiddleware)
+	}
+	for _, r := range routes {
+		if loadParamsHandler {
+			h = echoHandlerParams(paramNames(r.path))
+		}
+		switch r.method {
+		case "GET":
+			e.GET(r.path, h)
+		case "POST":
+			e.POST(r.path, h)
+		case "PUT":
+			e.PUT(r.path, h)
+		case "PATCH":
+			e.PATCH(r.path, h)
+		case "DELETE":
+			e.DELETE(r.path, h)
+		default:
+			panic("Unknow HTTP method: " + r.method)
+		}
+	}
+	return e
+}
+
+func loadEchoSingle(method, path string, h echo.HandlerFunc) http.Handler {
+	e := echo.New()
+	switch method {
+	case "GET":
+		e.GET(path, h)
+	case "POST":
+		e.POST(path, h)
+	case "PUT":
+		e.PUT(path, h)
+	case "PATCH":
+		e.PATCH(path, h)
+	case "DELETE":
+		e.DELETE(path, h)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return e
+}
+
+func loadEchoGroups(groups []routeGroup) http.Handler {
+	e := echo.New()
+
+	var add func(parent *echo.Group, prefix string, group routeGroup)
+	add = func(parent *echo.Group, prefix string, group routeGroup) {
+		var g *echo.Group
+		if parent == nil {
+			g = e.Group(group.prefix)
+		} else {
+			g = parent.Group(group.prefix)
+		}
+		prefix += group.prefix
+
+		for _, r := range group.routes {
+			var h echo.HandlerFunc = echoHandler
+			if loadTestHandler {
+				h = echoHandlerTest
+			}
+			if loadParamsHandler {
+				h = echoHandlerParams(paramNames(prefix + r.path))
+			}
+			switch r.method {
+			case "GET":
+				g.GET(r.path, h)
+			case "POST":
+				g.POST(r.path, h)
+			case "PUT":
+				g.PUT(r.path, h)
+			case "PATCH":
+				g.PATCH(r.path, h)
+			case "DELETE":
+				g.DELETE(r.path, h)
+			default:
+				panic("Unknow HTTP method: " + r.method)
+			}
+		}
+		for _, sub := range group.groups {
+			add(g, prefix, sub)
+		}
+	}
+	for _, group := range groups {
+		add(nil, "", group)
+	}
+	return e
+}
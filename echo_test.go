@@ -0,0 +1,255 @@
+//go:build !noecho
+// +build !noecho
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkEcho_Param(b *testing.B) {
+	router := loadEchoSingle("GET", "/user/:name", echoHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkEcho_Param5(b *testing.B) {
+	router := loadEchoSingle("GET", fiveColon, echoHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkEcho_Param20(b *testing.B) {
+	router := loadEchoSingle("GET", twentyColon, echoHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkEcho_ParamWrite(b *testing.B) {
+	router := loadEchoSingle("GET", "/user/:name", echoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkEcho_Param5Write(b *testing.B) {
+	router := loadEchoSingle("GET", fiveColon, echoHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkEcho_Param20Write(b *testing.B) {
+	router := loadEchoSingle("GET", twentyColon, echoHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkEcho_ParamEncodedWrite(b *testing.B) {
+	router := loadEchoSingle("GET", "/user/:name", echoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkEcho_ParamSlashWrite(b *testing.B) {
+	router := loadEchoSingle("GET", "/user/:name", echoHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkEcho_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Echo"], req)
+}
+
+// Param
+func BenchmarkEcho_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Echo"], req)
+}
+
+// Param and write all params
+func BenchmarkEcho_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadEcho, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkEcho_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Echo"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkEcho_GithubLoad(b *testing.B) {
+	benchLoad(b, loadEcho, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkEcho_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Echo"], req)
+}
+
+// One Param
+func BenchmarkEcho_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Echo"], req)
+}
+
+// Two Params
+func BenchmarkEcho_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Echo"], req)
+}
+
+// One Param and write
+func BenchmarkEcho_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadEcho, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkEcho_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadEcho, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkEcho_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Echo"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkEcho_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadEcho, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkEcho_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Echo"], req)
+}
+
+// One Param
+func BenchmarkEcho_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Echo"], req)
+}
+
+// Two Params
+func BenchmarkEcho_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Echo"], req)
+}
+
+// All Routes
+func BenchmarkEcho_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Echo"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkEcho_ParseLoad(b *testing.B) {
+	benchLoad(b, loadEcho, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkEcho_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Echo"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkEcho_StaticLoad(b *testing.B) {
+	benchLoad(b, loadEcho, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkEcho_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Echo"], req)
+}
+
+// Double slash
+func BenchmarkEcho_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Echo"], req)
+}
+
+// Dot segment
+func BenchmarkEcho_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Echo"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkEcho_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadEcho, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkEcho_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadEcho, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkEcho_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadEcho, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkEcho_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["Echo"], req)
+}
+
+// All routes
+func BenchmarkEcho_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["Echo"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkEcho_GithubReload(b *testing.B) {
+	benchReload(b, loadEcho, githubAPI)
+}
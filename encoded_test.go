@@ -0,0 +1,49 @@
+package main
+
+import "net/http"
+
+// Parameter values with escaped and special characters, as sent by clients.
+// The server decodes them into URL.Path and keeps the original in
+// URL.RawPath, if the decoded path does not encode back to it.
+var encodedParams = []string{
+	"a%2Fb",     // escaped slash, routers matching URL.Path see two segments
+	"a%20b",     // escaped space
+	"%C3%BCber", // UTF-8
+	"a+b",       // plus, which is not a space in paths
+}
+
+func init() {
+	for _, value := range encodedParams {
+		value := value
+		features = append(features, feature{
+			name: "/user/" + value,
+			check: func(router string, load func(routes []route) http.Handler) string {
+				if _, ok := routersWithoutParams[router]; ok {
+					return "-"
+				}
+				h := loadParams(load, []route{{method: "GET", path: "/user/:name"}})
+				return featureResult(h, newRawRequest("GET", "/user/"+value))
+			},
+		})
+	}
+
+	// A router which matches /user/a%2Fb must look at the escaped path, either
+	// URL.RawPath or the RequestURI.
+	features = append(features, feature{
+		name: "Matches on",
+		check: func(router string, load func(routes []route) http.Handler) string {
+			if _, ok := routersWithoutParams[router]; ok {
+				return "-"
+			}
+			h := loadParams(load, []route{{method: "GET", path: "/user/:name"}})
+			if featureResult(h, newRawRequest("GET", "/user/a%2Fb"))[0] == '"' {
+				return "escaped path"
+			}
+			return "URL.Path"
+		},
+	})
+}
+
+// The benchmarks of these requests are in the _test.go file of each router.
+// They are matched by routers looking at URL.Path and at the escaped path,
+// benchRequest sets the RequestURI to the escaped path.
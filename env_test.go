@@ -0,0 +1,253 @@
+package main
+
+import (
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	maxLoad = flag.Float64("maxload", 1, "warn if the 1 minute load average is above `load`")
+	maxCV   = flag.Float64("maxcv", 0.02, "warn if the coefficient of variation of the calibration loop is above `cv`")
+)
+
+// environment is the machine the benchmarks run on, read from /proc and /sys.
+// Values which cannot be read, e.g. on other systems than Linux, are empty.
+type environment struct {
+	cpuModel   string
+	cpuCores   int
+	governor   string // scaling governors of the CPUs, comma separated
+	turbo      string // on or off
+	kernel     string
+	gomaxprocs int
+	gogc       string
+	loadavg    []float64 // over 1, 5 and 15 minutes
+
+	// coefficient of variation of the rounds of the calibration loop, -1 if
+	// it did not run
+	calibrationCV float64
+}
+
+// readEnvironment reads the environment, without running the calibration
+// loop.
+func readEnvironment() environment {
+	env := environment{
+		cpuCores:      runtime.NumCPU(),
+		governor:      readGovernor(),
+		turbo:         readTurbo(),
+		kernel:        readFile("/proc/sys/kernel/osrelease"),
+		gomaxprocs:    runtime.GOMAXPROCS(0),
+		gogc:          os.Getenv("GOGC"),
+		calibrationCV: -1,
+	}
+	if env.gogc == "" {
+		env.gogc = "100"
+	}
+
+	for _, line := range strings.Split(readFile("/proc/cpuinfo"), "\n") {
+		if kv := strings.SplitN(line, ":", 2); len(kv) == 2 && strings.TrimSpace(kv[0]) == "model name" {
+			env.cpuModel = strings.TrimSpace(kv[1])
+			break
+		}
+	}
+
+	for _, field := range strings.Fields(readFile("/proc/loadavg")) {
+		load, err := strconv.ParseFloat(field, 64)
+		if err != nil || len(env.loadavg) == 3 {
+			break
+		}
+		env.loadavg = append(env.loadavg, load)
+	}
+	return env
+}
+
+// readFile returns the trimmed content of a file, or "" if it cannot be read.
+func readFile(name string) string {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// readGovernor returns the distinct scaling governors of all CPUs.
+func readGovernor() string {
+	files, _ := filepath.Glob("/sys/devices/system/cpu/cpu[0-9]*/cpufreq/scaling_governor")
+	governors := make(map[string]bool)
+	for _, file := range files {
+		if governor := readFile(file); governor != "" {
+			governors[governor] = true
+		}
+	}
+	var names []string
+	for governor := range governors {
+		names = append(names, governor)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// readTurbo returns whether Turbo Boost (intel_pstate) or the boost of the
+// cpufreq driver (e.g. acpi-cpufreq, AMD) is enabled.
+func readTurbo() string {
+	switch readFile("/sys/devices/system/cpu/intel_pstate/no_turbo") {
+	case "0":
+		return "on"
+	case "1":
+		return "off"
+	}
+	switch readFile("/sys/devices/system/cpu/cpufreq/boost") {
+	case "1":
+		return "on"
+	case "0":
+		return "off"
+	}
+	return ""
+}
+
+// calibration is a fixed workload, which takes the same time for every round
+// on a quiet machine.
+func calibration() {
+	var buf [1024]byte
+	for i := 0; i < 2000; i++ {
+		sum := sha256.Sum256(buf[:])
+		copy(buf[:], sum[:])
+	}
+}
+
+// calibrate runs the calibration loop and returns the duration of each round.
+func calibrate(rounds int) []time.Duration {
+	durations := make([]time.Duration, rounds)
+	calibration() // warm up
+	for i := range durations {
+		start := time.Now()
+		calibration()
+		durations[i] = time.Since(start)
+	}
+	return durations
+}
+
+// coefficientOfVariation returns the standard deviation of the durations
+// divided by their mean.
+func coefficientOfVariation(durations []time.Duration) float64 {
+	var mean float64
+	for _, d := range durations {
+		mean += float64(d)
+	}
+	mean /= float64(len(durations))
+
+	var variance float64
+	for _, d := range durations {
+		variance += (float64(d) - mean) * (float64(d) - mean)
+	}
+	variance /= float64(len(durations))
+	return math.Sqrt(variance) / mean
+}
+
+// config returns the environment as configuration lines of the benchmark
+// format, like printVersions, so that every result which follows records it.
+// Values which could not be read are left out.
+func (env environment) config() []string {
+	var loads []string
+	for _, load := range env.loadavg {
+		loads = append(loads, strconv.FormatFloat(load, 'f', 2, 64))
+	}
+	values := [][2]string{
+		{"cpu-model", env.cpuModel},
+		{"cpu-cores", strconv.Itoa(env.cpuCores)},
+		{"cpu-governor", env.governor},
+		{"cpu-turbo", env.turbo},
+		{"kernel", env.kernel},
+		{"gomaxprocs", strconv.Itoa(env.gomaxprocs)},
+		{"gogc", env.gogc},
+		{"loadavg", strings.Join(loads, " ")},
+	}
+	if env.calibrationCV >= 0 {
+		values = append(values, [2]string{"calibration-cv", fmt.Sprintf("%.2f%%", 100*env.calibrationCV)})
+	}
+
+	var lines []string
+	for _, v := range values {
+		if v[1] != "" {
+			lines = append(lines, v[0]+": "+v[1])
+		}
+	}
+	return lines
+}
+
+// noise returns warnings about everything in the environment which makes the
+// results noisy.
+func (env environment) noise() []string {
+	var warnings []string
+	for _, governor := range strings.Split(env.governor, ",") {
+		if governor != "" && governor != "performance" {
+			warnings = append(warnings, "CPU governor is "+env.governor+", set it to performance")
+			break
+		}
+	}
+	if len(env.loadavg) > 0 && env.loadavg[0] > *maxLoad {
+		warnings = append(warnings, fmt.Sprintf("load average is %.2f, above -maxload %g", env.loadavg[0], *maxLoad))
+	}
+	if env.turbo == "on" {
+		warnings = append(warnings, "turbo is enabled, the clock depends on the temperature")
+	}
+	if env.calibrationCV > *maxCV {
+		warnings = append(warnings, fmt.Sprintf(
+			"calibration loop varies by %.2f%%, above -maxcv %.2f%%", 100*env.calibrationCV, 100**maxCV,
+		))
+	}
+	return warnings
+}
+
+// printEnvironment prints the environment as configuration lines and warns
+// about noise. The calibration loop only runs if benchmarks do, the median of
+// its rounds is printed as the result of BenchmarkCalibration, which tells how
+// fast the machine is, see cmd/report.
+func printEnvironment() {
+	env := readEnvironment()
+	var durations []time.Duration
+	if bench := flag.Lookup("test.bench"); bench != nil && bench.Value.String() != "" {
+		durations = calibrate(20)
+		env.calibrationCV = coefficientOfVariation(durations)
+	}
+
+	for _, line := range env.config() {
+		fmt.Println(line)
+	}
+	if len(durations) > 0 {
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		fmt.Printf("BenchmarkCalibration\t%8d\t%10d ns/op\n", len(durations), durations[len(durations)/2])
+	}
+	if warnings := env.noise(); len(warnings) > 0 {
+		println("#Noisy Environment:", len(warnings))
+		for _, warning := range warnings {
+			println("   " + warning)
+		}
+		println()
+	}
+}
+
+func TestEnvironmentNoise(t *testing.T) {
+	quiet := environment{governor: "performance", turbo: "off", loadavg: []float64{0.1, 0.1, 0.1}, calibrationCV: 0.001}
+	if warnings := quiet.noise(); len(warnings) > 0 {
+		t.Errorf("quiet environment: %q; expected no warnings", warnings)
+	}
+
+	noisy := environment{governor: "performance,powersave", turbo: "on", loadavg: []float64{*maxLoad + 1}, calibrationCV: *maxCV + 0.01}
+	if warnings := noisy.noise(); len(warnings) != 4 {
+		t.Errorf("noisy environment: %q; expected 4 warnings", warnings)
+	}
+
+	if cv := coefficientOfVariation([]time.Duration{10, 10, 10}); cv != 0 {
+		t.Errorf("coefficientOfVariation of equal durations: %g; expected 0", cv)
+	}
+}
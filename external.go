@@ -0,0 +1,15 @@
+//go:build external
+// +build external
+
+package main
+
+// Routers outside of this repository are compiled in with -tags external.
+// The package external, which is not part of the suite (see .gitignore), only
+// imports the packages registering the adapters, e.g.
+//
+//	package external
+//
+//	import _ "example.com/team/router/benchadapter"
+//
+// see the package adapter.
+import _ "github.com/julienschmidt/go-http-routing-benchmark/external"
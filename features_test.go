@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var printFeatures = flag.Bool("features", false, "print the feature matrix")
+
+// feature is a column of the feature matrix. check loads the router and
+// returns how it behaves, see featureResult.
+type feature struct {
+	name  string
+	check func(router string, load func(routes []route) http.Handler) string
+}
+
+// features of the matrix, registered by the init functions of the scenarios
+var features []feature
+
+// TestFeatures prints a markdown table of how every router behaves in the
+// feature scenarios. It only runs if the -features flag is set:
+//
+//	go test -run=Features -features
+func TestFeatures(t *testing.T) {
+	if !*printFeatures {
+		t.Skip("run with -features to print the feature matrix")
+	}
+
+	row := "| Router |"
+	sep := "|:-------|"
+	for _, f := range features {
+		row += " " + f.name + " |"
+		sep += strings.Repeat("-", len(f.name)+1) + ":|"
+	}
+	fmt.Println(row)
+	fmt.Println(sep)
+
+	for _, router := range routers {
+		if !isTested(router.name) {
+			continue
+		}
+		row := "| " + router.name + " |"
+		for _, f := range features {
+			row += " " + f.check(router.name, router.load) + " |"
+		}
+		fmt.Println(row)
+	}
+}
+
+// newRawRequest parses a request for uri like the server does, so that the
+// path is not cleaned and escaped characters end up in URL.RawPath.
+func newRawRequest(method, uri string) *http.Request {
+	req, err := http.ReadRequest(bufio.NewReader(strings.NewReader(
+		method + " " + uri + " HTTP/1.1\r\nHost: example.com\r\n\r\n",
+	)))
+	if err != nil {
+		panic(err)
+	}
+	return req
+}
+
+// featureResult serves the request and describes the response: the
+// parameters written by the params handler if it was reached, the status
+// code and location for redirects and the status code otherwise.
+func featureResult(router http.Handler, req *http.Request) string {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	switch {
+	case w.Code == 200:
+		body := w.Body.String()
+		if body == "" {
+			return "200"
+		}
+		params := strings.Split(strings.TrimSuffix(body, "\n"), "\n")
+		for i, param := range params {
+			params[i] = fmt.Sprintf("%q", param)
+		}
+		return strings.Join(params, " ")
+	case w.Code >= 300 && w.Code < 400:
+		return fmt.Sprintf("%d %s", w.Code, w.Header().Get("Location"))
+	}
+	return fmt.Sprint(w.Code)
+}
@@ -0,0 +1,289 @@
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var (
+	fuzzAPI = flag.String("fuzzapi", "GitHub", "`API` whose routes FuzzRouters requests")
+	fuzzRef = flag.String("fuzzref", "HttpRouter", "`router` whose results are the reference for FuzzRouters, or \"majority\"")
+	fuzzLog = flag.String("fuzzlog", "", "append disagreements found by FuzzRouters to `file` instead of failing")
+)
+
+// path mutations applied by FuzzRouters
+const (
+	fuzzRawValue      = 1 << iota // use the parameter value unescaped
+	fuzzDoubleSlash               // insert an empty segment
+	fuzzDotSegment                // insert a /. segment
+	fuzzParentSegment             // insert a /x/.. segment
+	fuzzTrailingSlash             // append a slash
+	fuzzLongValue                 // repeat the parameter value to a very long segment
+)
+
+// fuzzResult is what a router did with a request
+type fuzzResult struct {
+	code     int
+	location string
+	params   string // written by the params handler, if it was reached
+	panic    string
+}
+
+func (r fuzzResult) String() string {
+	switch {
+	case r.panic != "":
+		return "panic: " + r.panic
+	case r.location != "":
+		return fmt.Sprintf("%d -> %s", r.code, r.location)
+	case r.code == 200:
+		return fmt.Sprintf("200 %q", r.params)
+	}
+	return fmt.Sprint(r.code)
+}
+
+// FuzzRouters requests random variations of the paths of an API from every
+// router which hands out route parameters and reports every request on which
+// a router disagrees with the reference about the status code, the redirect
+// location or the extracted parameters.
+//
+// The fuzzer starts from a concrete path of every route and mutates the
+// parameter values and the structure of the path:
+//
+//	go test -run=XXX -fuzz=FuzzRouters -fuzzref=majority -fuzzlog=disagreements.txt
+//
+// Without -fuzzlog the first disagreement fails the test, as usual when
+// fuzzing, and is added to the corpus in testdata/fuzz.
+func FuzzRouters(f *testing.F) {
+	var routes []route
+	for _, api := range apis {
+		if api.name == *fuzzAPI {
+			routes = api.routes
+		}
+	}
+	if routes == nil {
+		f.Fatalf("unknown API %q", *fuzzAPI)
+	}
+
+	var names []string
+	handlers := make(map[string]http.Handler)
+	loadParamsHandler = true
+	for _, router := range routers {
+		if _, ok := routersWithoutParams[router.name]; ok {
+			continue
+		}
+		names = append(names, router.name)
+		handlers[router.name] = router.load(routes)
+	}
+	loadParamsHandler = false
+
+	if _, ok := handlers[*fuzzRef]; !ok && *fuzzRef != "majority" {
+		if _, ok := routerConstraints[*fuzzRef]; ok {
+			f.Skipf("reference router %s is excluded, see routerConstraints", *fuzzRef)
+		}
+		f.Fatalf("unknown reference router %q", *fuzzRef)
+	}
+
+	for i := range routes {
+		f.Add(uint16(i), uint8(0), uint8(0), "x")
+	}
+
+	reported := make(map[string]bool)
+
+	f.Fuzz(func(t *testing.T, index uint16, mutation, pos uint8, value string) {
+		rt := routes[int(index)%len(routes)]
+		path := fuzzPath(rt.path, mutation, pos, value)
+
+		u, err := url.ParseRequestURI(path)
+		if err != nil || u.Path == "" {
+			return
+		}
+
+		results := make(map[string]fuzzResult, len(names))
+		for _, name := range names {
+			// routers may modify the URL, e.g. when redirecting
+			uc := *u
+			req := &http.Request{
+				Method:     rt.method,
+				URL:        &uc,
+				RequestURI: path,
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     make(http.Header),
+				Host:       "example.com",
+			}
+			results[name] = serveFuzz(handlers[name], req)
+		}
+
+		ref := *fuzzRef
+		if ref == "majority" {
+			ref = majority(names, results)
+		}
+		expected := results[ref]
+
+		var diff []string
+		signature := ref + " " + fmt.Sprint(expected.code)
+		for _, name := range names {
+			if results[name] != expected {
+				diff = append(diff, fmt.Sprintf("%s: %v (route %s)",
+					name, results[name], fuzzRoute(routes, rt.method, u, results[name])))
+				signature += " " + name + " " + fmt.Sprint(results[name].code)
+			}
+		}
+		if len(diff) == 0 {
+			return
+		}
+
+		report := fmt.Sprintf("%s %s\n\treference %s: %v (route %s)\n\t%s\n",
+			rt.method, path, ref, expected, fuzzRoute(routes, rt.method, u, expected),
+			strings.Join(diff, "\n\t"))
+
+		if *fuzzLog == "" {
+			t.Error(report)
+			return
+		}
+
+		// report each combination of disagreeing routers and status codes once
+		// per process, there are usually many inputs for the same difference
+		if reported[signature] {
+			return
+		}
+		reported[signature] = true
+
+		file, err := os.OpenFile(*fuzzLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		if _, err := file.WriteString(report); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// fuzzPath returns a request path for a route path, with the parameters
+// replaced by value and the mutations applied at segment pos.
+func fuzzPath(path string, mutation, pos uint8, value string) string {
+	if mutation&fuzzLongValue != 0 {
+		value = strings.Repeat(value+"x", 4096/(len(value)+1))
+	}
+	if mutation&fuzzRawValue == 0 {
+		value = url.PathEscape(value)
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = value
+		}
+	}
+
+	// the first segment is always empty, insert after it
+	i := 1 + int(pos)%len(segments)
+	if mutation&fuzzDoubleSlash != 0 {
+		segments = insertSegments(segments, i, "")
+	}
+	if mutation&fuzzDotSegment != 0 {
+		segments = insertSegments(segments, i, ".")
+	}
+	if mutation&fuzzParentSegment != 0 {
+		segments = insertSegments(segments, i, "x", "..")
+	}
+	if mutation&fuzzTrailingSlash != 0 {
+		segments = append(segments, "")
+	}
+	return strings.Join(segments, "/")
+}
+
+func insertSegments(segments []string, i int, insert ...string) []string {
+	if i > len(segments) {
+		i = len(segments)
+	}
+	return append(segments[:i], append(insert, segments[i:]...)...)
+}
+
+// serveFuzz serves the request and recovers from panics of the router.
+func serveFuzz(router http.Handler, req *http.Request) (result fuzzResult) {
+	w := httptest.NewRecorder()
+	defer func() {
+		if err := recover(); err != nil {
+			result = fuzzResult{panic: fmt.Sprint(err)}
+		}
+	}()
+
+	router.ServeHTTP(w, req)
+
+	result.code = w.Code
+	if w.Code >= 300 && w.Code < 400 {
+		result.location = w.Header().Get("Location")
+	}
+	if w.Code == 200 {
+		result.params = w.Body.String()
+	}
+	return result
+}
+
+// majority returns the name of a router with the most common result.
+func majority(names []string, results map[string]fuzzResult) string {
+	count := make(map[fuzzResult]int)
+	for _, name := range names {
+		count[results[name]]++
+	}
+
+	sorted := append([]string(nil), names...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return count[results[sorted[i]]] > count[results[sorted[j]]]
+	})
+	return sorted[0]
+}
+
+// fuzzRoute returns the route which a router must have matched to hand out the
+// parameters of the result, comparing the route with the decoded and the
+// escaped path of the request. It returns "-" if the router did not reach a
+// handler and "?" if no route fits.
+func fuzzRoute(routes []route, method string, u *url.URL, result fuzzResult) string {
+	if result.code != 200 {
+		return "-"
+	}
+
+	for _, path := range []string{u.Path, u.EscapedPath()} {
+		segments := strings.Split(path, "/")
+		for _, rt := range routes {
+			if rt.method != method {
+				continue
+			}
+			if params, ok := matchSegments(strings.Split(rt.path, "/"), segments); ok && params == result.params {
+				return rt.path
+			}
+		}
+	}
+	return "?"
+}
+
+// matchSegments compares the segments of a route path with the segments of a
+// request path and returns the parameters like the params handlers write them.
+func matchSegments(route, path []string) (string, bool) {
+	if len(route) != len(path) {
+		return "", false
+	}
+
+	params := ""
+	for i, segment := range route {
+		if strings.HasPrefix(segment, ":") {
+			params += path[i] + "\n"
+		} else if segment != path[i] {
+			return "", false
+		}
+	}
+	return params, true
+}
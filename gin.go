@@ -0,0 +1,109 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nogin
+// +build !nogin
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+var _ = registerRouter(benchRouter{
+	name:       "Gin",
+	pkg:        "github.com/gin-gonic/gin",
+	load:       loadGin,
+	loadGroups: loadGinGroups,
+})
+
+// Gin
+func ginHandle(_ *gin.Context) {}
+
+func ginHandleWrite(c *gin.Context) {
+	io.WriteString(c.Writer, c.Params.ByName("name"))
+}
+
+func ginHandleTest(c *gin.Context) {
+	io.WriteString(c.Writer, c.Request.RequestURI)
+}
+
+func ginMiddleware(c *gin.Context) {
+	middlewareCalls++
+	c.Next()
+}
+
+func ginHandleParams(names []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, name := range names {
+			io.WriteString(c.Writer, c.Params.ByName(name))
+			io.WriteString(c.Writer, "\n")
+		}
+	}
+}
+
+func init() {
+	gin.SetMode(gin.ReleaseMode)
+}
+
+func loadGin(routes []route) http.Handler {
+	var h gin.HandlerFunc = ginHandle
+	if loadTestHandler {
+		h = ginHandleTest
+	}
+
+	router := gin.New()
+	if loadCaseInsensitive {
+		// redirects to the path with the right case
+		router.RedirectFixedPath = true
+	}
+	// only applies to the routes added afterwards
+	for i := 0; i < loadMiddlewares; i++ {
+		router.Use(ginMiddleware)
+	}
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = ginHandleParams(paramNames(route.path))
+		}
+		router.Handle(route.method, route.path, h)
+	}
+	return router
+}
+
+func loadGinSingle(method, path string, handle gin.HandlerFunc) http.Handler {
+	router := gin.New()
+	router.Handle(method, path, handle)
+	return router
+}
+
+func loadGinGroups(groups []routeGroup) http.Handler {
+	router := gin.New()
+
+	var add func(parent *gin.RouterGroup, prefix string, group routeGroup)
+	add = func(parent *gin.RouterGroup, prefix string, group routeGroup) {
+		g := parent.Group(group.prefix)
+		prefix += group.prefix
+
+		for _, route := range group.routes {
+			var h gin.HandlerFunc = ginHandle
+			if loadTestHandler {
+				h = ginHandleTest
+			}
+			if loadParamsHandler {
+				h = ginHandleParams(paramNames(prefix + route.path))
+			}
+			g.Handle(route.method, route.path, h)
+		}
+		for _, sub := range group.groups {
+			add(g, prefix, sub)
+		}
+	}
+	for _, group := range groups {
+		add(&router.RouterGroup, "", group)
+	}
+	return router
+}
@@ -0,0 +1,265 @@
+//go:build !nogin
+// +build !nogin
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkGin_Param(b *testing.B) {
+	router := loadGinSingle("GET", "/user/:name", ginHandle)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkGin_Param5(b *testing.B) {
+	router := loadGinSingle("GET", fiveColon, ginHandle)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkGin_Param20(b *testing.B) {
+	router := loadGinSingle("GET", twentyColon, ginHandle)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkGin_ParamWrite(b *testing.B) {
+	router := loadGinSingle("GET", "/user/:name", ginHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkGin_Param5Write(b *testing.B) {
+	router := loadGinSingle("GET", fiveColon, ginHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkGin_Param20Write(b *testing.B) {
+	router := loadGinSingle("GET", twentyColon, ginHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkGin_ParamEncodedWrite(b *testing.B) {
+	router := loadGinSingle("GET", "/user/:name", ginHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkGin_ParamSlashWrite(b *testing.B) {
+	router := loadGinSingle("GET", "/user/:name", ginHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkGin_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Gin"], req)
+}
+
+// Param
+func BenchmarkGin_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Gin"], req)
+}
+
+// Param and write all params
+func BenchmarkGin_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadGin, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkGin_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Gin"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkGin_GithubLoad(b *testing.B) {
+	benchLoad(b, loadGin, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkGin_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Gin"], req)
+}
+
+// One Param
+func BenchmarkGin_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Gin"], req)
+}
+
+// Two Params
+func BenchmarkGin_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Gin"], req)
+}
+
+// One Param and write
+func BenchmarkGin_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadGin, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkGin_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadGin, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkGin_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Gin"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkGin_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadGin, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkGin_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Gin"], req)
+}
+
+// One Param
+func BenchmarkGin_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Gin"], req)
+}
+
+// Two Params
+func BenchmarkGin_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Gin"], req)
+}
+
+// All Routes
+func BenchmarkGin_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Gin"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkGin_ParseLoad(b *testing.B) {
+	benchLoad(b, loadGin, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkGin_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Gin"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkGin_StaticLoad(b *testing.B) {
+	benchLoad(b, loadGin, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkGin_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Gin"], req)
+}
+
+// Double slash
+func BenchmarkGin_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Gin"], req)
+}
+
+// Dot segment
+func BenchmarkGin_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Gin"], req)
+}
+
+// Case-insensitive lookup, see case_test.go
+
+// Case variant, with the routers configured to look up paths
+// case-insensitively
+func BenchmarkGin_GithubCaseInsensitive(b *testing.B) {
+	router := loadCaseInsensitiveRouter(loadGin, githubAPI)
+	req, _ := http.NewRequest("GET", "/Users/julienschmidt/Repos", nil)
+	benchVariant(b, router, req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkGin_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadGin, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkGin_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadGin, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkGin_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadGin, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkGin_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["Gin"], req)
+}
+
+// All routes
+func BenchmarkGin_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["Gin"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkGin_GithubReload(b *testing.B) {
+	benchReload(b, loadGin, githubAPI)
+}
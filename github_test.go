@@ -4,542 +4,279 @@
 
 package main
 
-import (
-	"net/http"
-	"testing"
-)
+import "net/http"
 
 // http://developer.github.com/v3/
 var githubAPI = []route{
 	// OAuth Authorizations
-	{"GET", "/authorizations"},
-	{"GET", "/authorizations/:id"},
-	{"POST", "/authorizations"},
-	//{"PUT", "/authorizations/clients/:client_id"},
-	//{"PATCH", "/authorizations/:id"},
-	{"DELETE", "/authorizations/:id"},
-	{"GET", "/applications/:client_id/tokens/:access_token"},
-	{"DELETE", "/applications/:client_id/tokens"},
-	{"DELETE", "/applications/:client_id/tokens/:access_token"},
+	{method: "GET", path: "/authorizations"},
+	{method: "GET", path: "/authorizations/:id"},
+	{method: "POST", path: "/authorizations"},
+	//{method: "PUT", path: "/authorizations/clients/:client_id"},
+	//{method: "PATCH", path: "/authorizations/:id"},
+	{method: "DELETE", path: "/authorizations/:id"},
+	{method: "GET", path: "/applications/:client_id/tokens/:access_token"},
+	{method: "DELETE", path: "/applications/:client_id/tokens"},
+	{method: "DELETE", path: "/applications/:client_id/tokens/:access_token"},
 
 	// Activity
-	{"GET", "/events"},
-	{"GET", "/repos/:owner/:repo/events"},
-	{"GET", "/networks/:owner/:repo/events"},
-	{"GET", "/orgs/:org/events"},
-	{"GET", "/users/:user/received_events"},
-	{"GET", "/users/:user/received_events/public"},
-	{"GET", "/users/:user/events"},
-	{"GET", "/users/:user/events/public"},
-	{"GET", "/users/:user/events/orgs/:org"},
-	{"GET", "/feeds"},
-	{"GET", "/notifications"},
-	{"GET", "/repos/:owner/:repo/notifications"},
-	{"PUT", "/notifications"},
-	{"PUT", "/repos/:owner/:repo/notifications"},
-	{"GET", "/notifications/threads/:id"},
-	//{"PATCH", "/notifications/threads/:id"},
-	{"GET", "/notifications/threads/:id/subscription"},
-	{"PUT", "/notifications/threads/:id/subscription"},
-	{"DELETE", "/notifications/threads/:id/subscription"},
-	{"GET", "/repos/:owner/:repo/stargazers"},
-	{"GET", "/users/:user/starred"},
-	{"GET", "/user/starred"},
-	{"GET", "/user/starred/:owner/:repo"},
-	{"PUT", "/user/starred/:owner/:repo"},
-	{"DELETE", "/user/starred/:owner/:repo"},
-	{"GET", "/repos/:owner/:repo/subscribers"},
-	{"GET", "/users/:user/subscriptions"},
-	{"GET", "/user/subscriptions"},
-	{"GET", "/repos/:owner/:repo/subscription"},
-	{"PUT", "/repos/:owner/:repo/subscription"},
-	{"DELETE", "/repos/:owner/:repo/subscription"},
-	{"GET", "/user/subscriptions/:owner/:repo"},
-	{"PUT", "/user/subscriptions/:owner/:repo"},
-	{"DELETE", "/user/subscriptions/:owner/:repo"},
+	{method: "GET", path: "/events"},
+	{method: "GET", path: "/repos/:owner/:repo/events"},
+	{method: "GET", path: "/networks/:owner/:repo/events"},
+	{method: "GET", path: "/orgs/:org/events"},
+	{method: "GET", path: "/users/:user/received_events"},
+	{method: "GET", path: "/users/:user/received_events/public"},
+	{method: "GET", path: "/users/:user/events"},
+	{method: "GET", path: "/users/:user/events/public"},
+	{method: "GET", path: "/users/:user/events/orgs/:org"},
+	{method: "GET", path: "/feeds"},
+	{method: "GET", path: "/notifications"},
+	{method: "GET", path: "/repos/:owner/:repo/notifications"},
+	{method: "PUT", path: "/notifications"},
+	{method: "PUT", path: "/repos/:owner/:repo/notifications"},
+	{method: "GET", path: "/notifications/threads/:id"},
+	//{method: "PATCH", path: "/notifications/threads/:id"},
+	{method: "GET", path: "/notifications/threads/:id/subscription"},
+	{method: "PUT", path: "/notifications/threads/:id/subscription"},
+	{method: "DELETE", path: "/notifications/threads/:id/subscription"},
+	{method: "GET", path: "/repos/:owner/:repo/stargazers"},
+	{method: "GET", path: "/users/:user/starred"},
+	{method: "GET", path: "/user/starred"},
+	{method: "GET", path: "/user/starred/:owner/:repo"},
+	{method: "PUT", path: "/user/starred/:owner/:repo"},
+	{method: "DELETE", path: "/user/starred/:owner/:repo"},
+	{method: "GET", path: "/repos/:owner/:repo/subscribers"},
+	{method: "GET", path: "/users/:user/subscriptions"},
+	{method: "GET", path: "/user/subscriptions"},
+	{method: "GET", path: "/repos/:owner/:repo/subscription"},
+	{method: "PUT", path: "/repos/:owner/:repo/subscription"},
+	{method: "DELETE", path: "/repos/:owner/:repo/subscription"},
+	{method: "GET", path: "/user/subscriptions/:owner/:repo"},
+	{method: "PUT", path: "/user/subscriptions/:owner/:repo"},
+	{method: "DELETE", path: "/user/subscriptions/:owner/:repo"},
 
 	// Gists
-	{"GET", "/users/:user/gists"},
-	{"GET", "/gists"},
-	//{"GET", "/gists/public"},
-	//{"GET", "/gists/starred"},
-	{"GET", "/gists/:id"},
-	{"POST", "/gists"},
-	//{"PATCH", "/gists/:id"},
-	{"PUT", "/gists/:id/star"},
-	{"DELETE", "/gists/:id/star"},
-	{"GET", "/gists/:id/star"},
-	{"POST", "/gists/:id/forks"},
-	{"DELETE", "/gists/:id"},
+	{method: "GET", path: "/users/:user/gists"},
+	{method: "GET", path: "/gists"},
+	//{method: "GET", path: "/gists/public"},
+	//{method: "GET", path: "/gists/starred"},
+	{method: "GET", path: "/gists/:id"},
+	{method: "POST", path: "/gists"},
+	//{method: "PATCH", path: "/gists/:id"},
+	{method: "PUT", path: "/gists/:id/star"},
+	{method: "DELETE", path: "/gists/:id/star"},
+	{method: "GET", path: "/gists/:id/star"},
+	{method: "POST", path: "/gists/:id/forks"},
+	{method: "DELETE", path: "/gists/:id"},
 
 	// Git Data
-	{"GET", "/repos/:owner/:repo/git/blobs/:sha"},
-	{"POST", "/repos/:owner/:repo/git/blobs"},
-	{"GET", "/repos/:owner/:repo/git/commits/:sha"},
-	{"POST", "/repos/:owner/:repo/git/commits"},
-	//{"GET", "/repos/:owner/:repo/git/refs/*ref"},
-	{"GET", "/repos/:owner/:repo/git/refs"},
-	{"POST", "/repos/:owner/:repo/git/refs"},
-	//{"PATCH", "/repos/:owner/:repo/git/refs/*ref"},
-	//{"DELETE", "/repos/:owner/:repo/git/refs/*ref"},
-	{"GET", "/repos/:owner/:repo/git/tags/:sha"},
-	{"POST", "/repos/:owner/:repo/git/tags"},
-	{"GET", "/repos/:owner/:repo/git/trees/:sha"},
-	{"POST", "/repos/:owner/:repo/git/trees"},
+	{method: "GET", path: "/repos/:owner/:repo/git/blobs/:sha"},
+	{method: "POST", path: "/repos/:owner/:repo/git/blobs"},
+	{method: "GET", path: "/repos/:owner/:repo/git/commits/:sha"},
+	{method: "POST", path: "/repos/:owner/:repo/git/commits"},
+	//{method: "GET", path: "/repos/:owner/:repo/git/refs/*ref"},
+	{method: "GET", path: "/repos/:owner/:repo/git/refs"},
+	{method: "POST", path: "/repos/:owner/:repo/git/refs"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/git/refs/*ref"},
+	//{method: "DELETE", path: "/repos/:owner/:repo/git/refs/*ref"},
+	{method: "GET", path: "/repos/:owner/:repo/git/tags/:sha"},
+	{method: "POST", path: "/repos/:owner/:repo/git/tags"},
+	{method: "GET", path: "/repos/:owner/:repo/git/trees/:sha"},
+	{method: "POST", path: "/repos/:owner/:repo/git/trees"},
 
 	// Issues
-	{"GET", "/issues"},
-	{"GET", "/user/issues"},
-	{"GET", "/orgs/:org/issues"},
-	{"GET", "/repos/:owner/:repo/issues"},
-	{"GET", "/repos/:owner/:repo/issues/:number"},
-	{"POST", "/repos/:owner/:repo/issues"},
-	//{"PATCH", "/repos/:owner/:repo/issues/:number"},
-	{"GET", "/repos/:owner/:repo/assignees"},
-	{"GET", "/repos/:owner/:repo/assignees/:assignee"},
-	{"GET", "/repos/:owner/:repo/issues/:number/comments"},
-	//{"GET", "/repos/:owner/:repo/issues/comments"},
-	//{"GET", "/repos/:owner/:repo/issues/comments/:id"},
-	{"POST", "/repos/:owner/:repo/issues/:number/comments"},
-	//{"PATCH", "/repos/:owner/:repo/issues/comments/:id"},
-	//{"DELETE", "/repos/:owner/:repo/issues/comments/:id"},
-	{"GET", "/repos/:owner/:repo/issues/:number/events"},
-	//{"GET", "/repos/:owner/:repo/issues/events"},
-	//{"GET", "/repos/:owner/:repo/issues/events/:id"},
-	{"GET", "/repos/:owner/:repo/labels"},
-	{"GET", "/repos/:owner/:repo/labels/:name"},
-	{"POST", "/repos/:owner/:repo/labels"},
-	//{"PATCH", "/repos/:owner/:repo/labels/:name"},
-	{"DELETE", "/repos/:owner/:repo/labels/:name"},
-	{"GET", "/repos/:owner/:repo/issues/:number/labels"},
-	{"POST", "/repos/:owner/:repo/issues/:number/labels"},
-	{"DELETE", "/repos/:owner/:repo/issues/:number/labels/:name"},
-	{"PUT", "/repos/:owner/:repo/issues/:number/labels"},
-	{"DELETE", "/repos/:owner/:repo/issues/:number/labels"},
-	{"GET", "/repos/:owner/:repo/milestones/:number/labels"},
-	{"GET", "/repos/:owner/:repo/milestones"},
-	{"GET", "/repos/:owner/:repo/milestones/:number"},
-	{"POST", "/repos/:owner/:repo/milestones"},
-	//{"PATCH", "/repos/:owner/:repo/milestones/:number"},
-	{"DELETE", "/repos/:owner/:repo/milestones/:number"},
+	{method: "GET", path: "/issues"},
+	{method: "GET", path: "/user/issues"},
+	{method: "GET", path: "/orgs/:org/issues"},
+	{method: "GET", path: "/repos/:owner/:repo/issues"},
+	{method: "GET", path: "/repos/:owner/:repo/issues/:number"},
+	{method: "POST", path: "/repos/:owner/:repo/issues"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/issues/:number"},
+	{method: "GET", path: "/repos/:owner/:repo/assignees"},
+	{method: "GET", path: "/repos/:owner/:repo/assignees/:assignee"},
+	{method: "GET", path: "/repos/:owner/:repo/issues/:number/comments"},
+	//{method: "GET", path: "/repos/:owner/:repo/issues/comments"},
+	//{method: "GET", path: "/repos/:owner/:repo/issues/comments/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/issues/:number/comments"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/issues/comments/:id"},
+	//{method: "DELETE", path: "/repos/:owner/:repo/issues/comments/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/issues/:number/events"},
+	//{method: "GET", path: "/repos/:owner/:repo/issues/events"},
+	//{method: "GET", path: "/repos/:owner/:repo/issues/events/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/labels"},
+	{method: "GET", path: "/repos/:owner/:repo/labels/:name"},
+	{method: "POST", path: "/repos/:owner/:repo/labels"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/labels/:name"},
+	{method: "DELETE", path: "/repos/:owner/:repo/labels/:name"},
+	{method: "GET", path: "/repos/:owner/:repo/issues/:number/labels"},
+	{method: "POST", path: "/repos/:owner/:repo/issues/:number/labels"},
+	{method: "DELETE", path: "/repos/:owner/:repo/issues/:number/labels/:name"},
+	{method: "PUT", path: "/repos/:owner/:repo/issues/:number/labels"},
+	{method: "DELETE", path: "/repos/:owner/:repo/issues/:number/labels"},
+	{method: "GET", path: "/repos/:owner/:repo/milestones/:number/labels"},
+	{method: "GET", path: "/repos/:owner/:repo/milestones"},
+	{method: "GET", path: "/repos/:owner/:repo/milestones/:number"},
+	{method: "POST", path: "/repos/:owner/:repo/milestones"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/milestones/:number"},
+	{method: "DELETE", path: "/repos/:owner/:repo/milestones/:number"},
 
 	// Miscellaneous
-	{"GET", "/emojis"},
-	{"GET", "/gitignore/templates"},
-	{"GET", "/gitignore/templates/:name"},
-	{"POST", "/markdown"},
-	{"POST", "/markdown/raw"},
-	{"GET", "/meta"},
-	{"GET", "/rate_limit"},
+	{method: "GET", path: "/emojis"},
+	{method: "GET", path: "/gitignore/templates"},
+	{method: "GET", path: "/gitignore/templates/:name"},
+	{method: "POST", path: "/markdown"},
+	{method: "POST", path: "/markdown/raw"},
+	{method: "GET", path: "/meta"},
+	{method: "GET", path: "/rate_limit"},
 
 	// Organizations
-	{"GET", "/users/:user/orgs"},
-	{"GET", "/user/orgs"},
-	{"GET", "/orgs/:org"},
-	//{"PATCH", "/orgs/:org"},
-	{"GET", "/orgs/:org/members"},
-	{"GET", "/orgs/:org/members/:user"},
-	{"DELETE", "/orgs/:org/members/:user"},
-	{"GET", "/orgs/:org/public_members"},
-	{"GET", "/orgs/:org/public_members/:user"},
-	{"PUT", "/orgs/:org/public_members/:user"},
-	{"DELETE", "/orgs/:org/public_members/:user"},
-	{"GET", "/orgs/:org/teams"},
-	{"GET", "/teams/:id"},
-	{"POST", "/orgs/:org/teams"},
-	//{"PATCH", "/teams/:id"},
-	{"DELETE", "/teams/:id"},
-	{"GET", "/teams/:id/members"},
-	{"GET", "/teams/:id/members/:user"},
-	{"PUT", "/teams/:id/members/:user"},
-	{"DELETE", "/teams/:id/members/:user"},
-	{"GET", "/teams/:id/repos"},
-	{"GET", "/teams/:id/repos/:owner/:repo"},
-	{"PUT", "/teams/:id/repos/:owner/:repo"},
-	{"DELETE", "/teams/:id/repos/:owner/:repo"},
-	{"GET", "/user/teams"},
+	{method: "GET", path: "/users/:user/orgs"},
+	{method: "GET", path: "/user/orgs"},
+	{method: "GET", path: "/orgs/:org"},
+	//{method: "PATCH", path: "/orgs/:org"},
+	{method: "GET", path: "/orgs/:org/members"},
+	{method: "GET", path: "/orgs/:org/members/:user"},
+	{method: "DELETE", path: "/orgs/:org/members/:user"},
+	{method: "GET", path: "/orgs/:org/public_members"},
+	{method: "GET", path: "/orgs/:org/public_members/:user"},
+	{method: "PUT", path: "/orgs/:org/public_members/:user"},
+	{method: "DELETE", path: "/orgs/:org/public_members/:user"},
+	{method: "GET", path: "/orgs/:org/teams"},
+	{method: "GET", path: "/teams/:id"},
+	{method: "POST", path: "/orgs/:org/teams"},
+	//{method: "PATCH", path: "/teams/:id"},
+	{method: "DELETE", path: "/teams/:id"},
+	{method: "GET", path: "/teams/:id/members"},
+	{method: "GET", path: "/teams/:id/members/:user"},
+	{method: "PUT", path: "/teams/:id/members/:user"},
+	{method: "DELETE", path: "/teams/:id/members/:user"},
+	{method: "GET", path: "/teams/:id/repos"},
+	{method: "GET", path: "/teams/:id/repos/:owner/:repo"},
+	{method: "PUT", path: "/teams/:id/repos/:owner/:repo"},
+	{method: "DELETE", path: "/teams/:id/repos/:owner/:repo"},
+	{method: "GET", path: "/user/teams"},
 
 	// Pull Requests
-	{"GET", "/repos/:owner/:repo/pulls"},
-	{"GET", "/repos/:owner/:repo/pulls/:number"},
-	{"POST", "/repos/:owner/:repo/pulls"},
-	//{"PATCH", "/repos/:owner/:repo/pulls/:number"},
-	{"GET", "/repos/:owner/:repo/pulls/:number/commits"},
-	{"GET", "/repos/:owner/:repo/pulls/:number/files"},
-	{"GET", "/repos/:owner/:repo/pulls/:number/merge"},
-	{"PUT", "/repos/:owner/:repo/pulls/:number/merge"},
-	{"GET", "/repos/:owner/:repo/pulls/:number/comments"},
-	//{"GET", "/repos/:owner/:repo/pulls/comments"},
-	//{"GET", "/repos/:owner/:repo/pulls/comments/:number"},
-	{"PUT", "/repos/:owner/:repo/pulls/:number/comments"},
-	//{"PATCH", "/repos/:owner/:repo/pulls/comments/:number"},
-	//{"DELETE", "/repos/:owner/:repo/pulls/comments/:number"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls/:number"},
+	{method: "POST", path: "/repos/:owner/:repo/pulls"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/pulls/:number"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls/:number/commits"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls/:number/files"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls/:number/merge"},
+	{method: "PUT", path: "/repos/:owner/:repo/pulls/:number/merge"},
+	{method: "GET", path: "/repos/:owner/:repo/pulls/:number/comments"},
+	//{method: "GET", path: "/repos/:owner/:repo/pulls/comments"},
+	//{method: "GET", path: "/repos/:owner/:repo/pulls/comments/:number"},
+	{method: "PUT", path: "/repos/:owner/:repo/pulls/:number/comments"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/pulls/comments/:number"},
+	//{method: "DELETE", path: "/repos/:owner/:repo/pulls/comments/:number"},
 
 	// Repositories
-	{"GET", "/user/repos"},
-	{"GET", "/users/:user/repos"},
-	{"GET", "/orgs/:org/repos"},
-	{"GET", "/repositories"},
-	{"POST", "/user/repos"},
-	{"POST", "/orgs/:org/repos"},
-	{"GET", "/repos/:owner/:repo"},
-	//{"PATCH", "/repos/:owner/:repo"},
-	{"GET", "/repos/:owner/:repo/contributors"},
-	{"GET", "/repos/:owner/:repo/languages"},
-	{"GET", "/repos/:owner/:repo/teams"},
-	{"GET", "/repos/:owner/:repo/tags"},
-	{"GET", "/repos/:owner/:repo/branches"},
-	{"GET", "/repos/:owner/:repo/branches/:branch"},
-	{"DELETE", "/repos/:owner/:repo"},
-	{"GET", "/repos/:owner/:repo/collaborators"},
-	{"GET", "/repos/:owner/:repo/collaborators/:user"},
-	{"PUT", "/repos/:owner/:repo/collaborators/:user"},
-	{"DELETE", "/repos/:owner/:repo/collaborators/:user"},
-	{"GET", "/repos/:owner/:repo/comments"},
-	{"GET", "/repos/:owner/:repo/commits/:sha/comments"},
-	{"POST", "/repos/:owner/:repo/commits/:sha/comments"},
-	{"GET", "/repos/:owner/:repo/comments/:id"},
-	//{"PATCH", "/repos/:owner/:repo/comments/:id"},
-	{"DELETE", "/repos/:owner/:repo/comments/:id"},
-	{"GET", "/repos/:owner/:repo/commits"},
-	{"GET", "/repos/:owner/:repo/commits/:sha"},
-	{"GET", "/repos/:owner/:repo/readme"},
-	//{"GET", "/repos/:owner/:repo/contents/*path"},
-	//{"PUT", "/repos/:owner/:repo/contents/*path"},
-	//{"DELETE", "/repos/:owner/:repo/contents/*path"},
-	//{"GET", "/repos/:owner/:repo/:archive_format/:ref"},
-	{"GET", "/repos/:owner/:repo/keys"},
-	{"GET", "/repos/:owner/:repo/keys/:id"},
-	{"POST", "/repos/:owner/:repo/keys"},
-	//{"PATCH", "/repos/:owner/:repo/keys/:id"},
-	{"DELETE", "/repos/:owner/:repo/keys/:id"},
-	{"GET", "/repos/:owner/:repo/downloads"},
-	{"GET", "/repos/:owner/:repo/downloads/:id"},
-	{"DELETE", "/repos/:owner/:repo/downloads/:id"},
-	{"GET", "/repos/:owner/:repo/forks"},
-	{"POST", "/repos/:owner/:repo/forks"},
-	{"GET", "/repos/:owner/:repo/hooks"},
-	{"GET", "/repos/:owner/:repo/hooks/:id"},
-	{"POST", "/repos/:owner/:repo/hooks"},
-	//{"PATCH", "/repos/:owner/:repo/hooks/:id"},
-	{"POST", "/repos/:owner/:repo/hooks/:id/tests"},
-	{"DELETE", "/repos/:owner/:repo/hooks/:id"},
-	{"POST", "/repos/:owner/:repo/merges"},
-	{"GET", "/repos/:owner/:repo/releases"},
-	{"GET", "/repos/:owner/:repo/releases/:id"},
-	{"POST", "/repos/:owner/:repo/releases"},
-	//{"PATCH", "/repos/:owner/:repo/releases/:id"},
-	{"DELETE", "/repos/:owner/:repo/releases/:id"},
-	{"GET", "/repos/:owner/:repo/releases/:id/assets"},
-	{"GET", "/repos/:owner/:repo/stats/contributors"},
-	{"GET", "/repos/:owner/:repo/stats/commit_activity"},
-	{"GET", "/repos/:owner/:repo/stats/code_frequency"},
-	{"GET", "/repos/:owner/:repo/stats/participation"},
-	{"GET", "/repos/:owner/:repo/stats/punch_card"},
-	{"GET", "/repos/:owner/:repo/statuses/:ref"},
-	{"POST", "/repos/:owner/:repo/statuses/:ref"},
+	{method: "GET", path: "/user/repos"},
+	{method: "GET", path: "/users/:user/repos"},
+	{method: "GET", path: "/orgs/:org/repos"},
+	{method: "GET", path: "/repositories"},
+	{method: "POST", path: "/user/repos"},
+	{method: "POST", path: "/orgs/:org/repos"},
+	{method: "GET", path: "/repos/:owner/:repo"},
+	//{method: "PATCH", path: "/repos/:owner/:repo"},
+	{method: "GET", path: "/repos/:owner/:repo/contributors"},
+	{method: "GET", path: "/repos/:owner/:repo/languages"},
+	{method: "GET", path: "/repos/:owner/:repo/teams"},
+	{method: "GET", path: "/repos/:owner/:repo/tags"},
+	{method: "GET", path: "/repos/:owner/:repo/branches"},
+	{method: "GET", path: "/repos/:owner/:repo/branches/:branch"},
+	{method: "DELETE", path: "/repos/:owner/:repo"},
+	{method: "GET", path: "/repos/:owner/:repo/collaborators"},
+	{method: "GET", path: "/repos/:owner/:repo/collaborators/:user"},
+	{method: "PUT", path: "/repos/:owner/:repo/collaborators/:user"},
+	{method: "DELETE", path: "/repos/:owner/:repo/collaborators/:user"},
+	{method: "GET", path: "/repos/:owner/:repo/comments"},
+	{method: "GET", path: "/repos/:owner/:repo/commits/:sha/comments"},
+	{method: "POST", path: "/repos/:owner/:repo/commits/:sha/comments"},
+	{method: "GET", path: "/repos/:owner/:repo/comments/:id"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/comments/:id"},
+	{method: "DELETE", path: "/repos/:owner/:repo/comments/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/commits"},
+	{method: "GET", path: "/repos/:owner/:repo/commits/:sha"},
+	{method: "GET", path: "/repos/:owner/:repo/readme"},
+	//{method: "GET", path: "/repos/:owner/:repo/contents/*path"},
+	//{method: "PUT", path: "/repos/:owner/:repo/contents/*path"},
+	//{method: "DELETE", path: "/repos/:owner/:repo/contents/*path"},
+	//{method: "GET", path: "/repos/:owner/:repo/:archive_format/:ref"},
+	{method: "GET", path: "/repos/:owner/:repo/keys"},
+	{method: "GET", path: "/repos/:owner/:repo/keys/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/keys"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/keys/:id"},
+	{method: "DELETE", path: "/repos/:owner/:repo/keys/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/downloads"},
+	{method: "GET", path: "/repos/:owner/:repo/downloads/:id"},
+	{method: "DELETE", path: "/repos/:owner/:repo/downloads/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/forks"},
+	{method: "POST", path: "/repos/:owner/:repo/forks"},
+	{method: "GET", path: "/repos/:owner/:repo/hooks"},
+	{method: "GET", path: "/repos/:owner/:repo/hooks/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/hooks"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/hooks/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/hooks/:id/tests"},
+	{method: "DELETE", path: "/repos/:owner/:repo/hooks/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/merges"},
+	{method: "GET", path: "/repos/:owner/:repo/releases"},
+	{method: "GET", path: "/repos/:owner/:repo/releases/:id"},
+	{method: "POST", path: "/repos/:owner/:repo/releases"},
+	//{method: "PATCH", path: "/repos/:owner/:repo/releases/:id"},
+	{method: "DELETE", path: "/repos/:owner/:repo/releases/:id"},
+	{method: "GET", path: "/repos/:owner/:repo/releases/:id/assets"},
+	{method: "GET", path: "/repos/:owner/:repo/stats/contributors"},
+	{method: "GET", path: "/repos/:owner/:repo/stats/commit_activity"},
+	{method: "GET", path: "/repos/:owner/:repo/stats/code_frequency"},
+	{method: "GET", path: "/repos/:owner/:repo/stats/participation"},
+	{method: "GET", path: "/repos/:owner/:repo/stats/punch_card"},
+	{method: "GET", path: "/repos/:owner/:repo/statuses/:ref"},
+	{method: "POST", path: "/repos/:owner/:repo/statuses/:ref"},
 
 	// Search
-	{"GET", "/search/repositories"},
-	{"GET", "/search/code"},
-	{"GET", "/search/issues"},
-	{"GET", "/search/users"},
-	{"GET", "/legacy/issues/search/:owner/:repository/:state/:keyword"},
-	{"GET", "/legacy/repos/search/:keyword"},
-	{"GET", "/legacy/user/search/:keyword"},
-	{"GET", "/legacy/user/email/:email"},
+	{method: "GET", path: "/search/repositories"},
+	{method: "GET", path: "/search/code"},
+	{method: "GET", path: "/search/issues"},
+	{method: "GET", path: "/search/users"},
+	{method: "GET", path: "/legacy/issues/search/:owner/:repository/:state/:keyword"},
+	{method: "GET", path: "/legacy/repos/search/:keyword"},
+	{method: "GET", path: "/legacy/user/search/:keyword"},
+	{method: "GET", path: "/legacy/user/email/:email"},
 
 	// Users
-	{"GET", "/users/:user"},
-	{"GET", "/user"},
-	//{"PATCH", "/user"},
-	{"GET", "/users"},
-	{"GET", "/user/emails"},
-	{"POST", "/user/emails"},
-	{"DELETE", "/user/emails"},
-	{"GET", "/users/:user/followers"},
-	{"GET", "/user/followers"},
-	{"GET", "/users/:user/following"},
-	{"GET", "/user/following"},
-	{"GET", "/user/following/:user"},
-	{"GET", "/users/:user/following/:target_user"},
-	{"PUT", "/user/following/:user"},
-	{"DELETE", "/user/following/:user"},
-	{"GET", "/users/:user/keys"},
-	{"GET", "/user/keys"},
-	{"GET", "/user/keys/:id"},
-	{"POST", "/user/keys"},
-	//{"PATCH", "/user/keys/:id"},
-	{"DELETE", "/user/keys/:id"},
+	{method: "GET", path: "/users/:user"},
+	{method: "GET", path: "/user"},
+	//{method: "PATCH", path: "/user"},
+	{method: "GET", path: "/users"},
+	{method: "GET", path: "/user/emails"},
+	{method: "POST", path: "/user/emails"},
+	{method: "DELETE", path: "/user/emails"},
+	{method: "GET", path: "/users/:user/followers"},
+	{method: "GET", path: "/user/followers"},
+	{method: "GET", path: "/users/:user/following"},
+	{method: "GET", path: "/user/following"},
+	{method: "GET", path: "/user/following/:user"},
+	{method: "GET", path: "/users/:user/following/:target_user"},
+	{method: "PUT", path: "/user/following/:user"},
+	{method: "DELETE", path: "/user/following/:user"},
+	{method: "GET", path: "/users/:user/keys"},
+	{method: "GET", path: "/user/keys"},
+	{method: "GET", path: "/user/keys/:id"},
+	{method: "POST", path: "/user/keys"},
+	//{method: "PATCH", path: "/user/keys/:id"},
+	{method: "DELETE", path: "/user/keys/:id"},
 }
 
-var (
-	githubAce         http.Handler
-	githubBadger      http.Handler
-	githubBear        http.Handler
-	githubDenco       http.Handler
-	githubEcho        http.Handler
-	githubGin         http.Handler
-	githubGoJsonRest  http.Handler
-	githubGorillaMux  http.Handler
-	githubHttpRouter  http.Handler
-	githubHttpTreeMux http.Handler
-	githubLARS        http.Handler
-	githubMartini     http.Handler
-	githubPossum      http.Handler
-	githubR2router    http.Handler
-	githubRivet       http.Handler
-	githubVulcan      http.Handler
-	// githubZeus        http.Handler
-)
+// the GitHub API loaded into every router, by name
+var githubRouters = make(map[string]http.Handler)
 
 func init() {
 	println("#GithubAPI Routes:", len(githubAPI))
 
-	calcMem("Ace", func() {
-		githubAce = loadAce(githubAPI)
-	})
-	calcMem("Badger", func() {
-		githubBadger = loadBadger(githubAPI)
-	})
-	calcMem("Bear", func() {
-		githubBear = loadBear(githubAPI)
-	})
-	calcMem("Denco", func() {
-		githubDenco = loadDenco(githubAPI)
-	})
-	calcMem("Echo", func() {
-		githubEcho = loadEcho(githubAPI)
-	})
-	calcMem("Gin", func() {
-		githubGin = loadGin(githubAPI)
-	})
-	calcMem("GoJsonRest", func() {
-		githubGoJsonRest = loadGoJsonRest(githubAPI)
-	})
-	calcMem("GorillaMux", func() {
-		githubGorillaMux = loadGorillaMux(githubAPI)
-	})
-	calcMem("HttpRouter", func() {
-		githubHttpRouter = loadHttpRouter(githubAPI)
-	})
-	calcMem("HttpTreeMux", func() {
-		githubHttpTreeMux = loadHttpTreeMux(githubAPI)
-	})
-	calcMem("LARS", func() {
-		githubLARS = loadLARS(githubAPI)
-	})
-	calcMem("Martini", func() {
-		githubMartini = loadMartini(githubAPI)
-	})
-	calcMem("Possum", func() {
-		githubPossum = loadPossum(githubAPI)
-	})
-	calcMem("R2router", func() {
-		githubR2router = loadR2router(githubAPI)
-	})
-	calcMem("Rivet", func() {
-		githubRivet = loadRivet(githubAPI)
-	})
-	calcMem("Vulcan", func() {
-		githubVulcan = loadVulcan(githubAPI)
-	})
-	// calcMem("Zeus", func() {
-	// 	githubZeus = loadZeus(githubAPI)
-	// })
+	loadRouters(githubRouters, githubAPI)
 
 	println()
 }
-
-// Static
-func BenchmarkAce_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubAce, req)
-}
-func BenchmarkBadger_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubBadger, req)
-}
-func BenchmarkBear_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubBear, req)
-}
-func BenchmarkDenco_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubDenco, req)
-}
-func BenchmarkEcho_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubEcho, req)
-}
-func BenchmarkGin_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubGin, req)
-}
-func BenchmarkGoJsonRest_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubGoJsonRest, req)
-}
-func BenchmarkGorillaMux_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubGorillaMux, req)
-}
-func BenchmarkHttpRouter_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubHttpRouter, req)
-}
-func BenchmarkHttpTreeMux_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubHttpTreeMux, req)
-}
-func BenchmarkLARS_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubLARS, req)
-}
-func BenchmarkMartini_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubMartini, req)
-}
-func BenchmarkPossum_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubPossum, req)
-}
-func BenchmarkR2router_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubR2router, req)
-}
-func BenchmarkRivet_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubRivet, req)
-}
-func BenchmarkVulcan_GithubStatic(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/user/repos", nil)
-	benchRequest(b, githubVulcan, req)
-}
-
-// func BenchmarkZeus_GithubStatic(b *testing.B) {
-// 	req, _ := http.NewRequest("GET", "/user/repos", nil)
-// 	benchRequest(b, githubZeus, req)
-// }
-
-// Param
-func BenchmarkAce_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubAce, req)
-}
-func BenchmarkBadger_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubBadger, req)
-}
-func BenchmarkBear_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubBear, req)
-}
-func BenchmarkDenco_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubDenco, req)
-}
-func BenchmarkEcho_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubEcho, req)
-}
-func BenchmarkGin_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubGin, req)
-}
-func BenchmarkGoJsonRest_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubGoJsonRest, req)
-}
-func BenchmarkGorillaMux_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubGorillaMux, req)
-}
-func BenchmarkHttpRouter_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubHttpRouter, req)
-}
-func BenchmarkHttpTreeMux_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubHttpTreeMux, req)
-}
-func BenchmarkLARS_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubLARS, req)
-}
-func BenchmarkMartini_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubMartini, req)
-}
-func BenchmarkPossum_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubPossum, req)
-}
-func BenchmarkR2router_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubR2router, req)
-}
-func BenchmarkRivet_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubRivet, req)
-}
-func BenchmarkVulcan_GithubParam(b *testing.B) {
-	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-	benchRequest(b, githubVulcan, req)
-}
-
-// func BenchmarkZeus_GithubParam(b *testing.B) {
-// 	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
-// 	benchRequest(b, githubZeus, req)
-// }
-
-// All routes
-func BenchmarkAce_GithubAll(b *testing.B) {
-	benchRoutes(b, githubAce, githubAPI)
-}
-func BenchmarkBear_GithubAll(b *testing.B) {
-	benchRoutes(b, githubBear, githubAPI)
-}
-func BenchmarkDenco_GithubAll(b *testing.B) {
-	benchRoutes(b, githubDenco, githubAPI)
-}
-func BenchmarkEcho_GithubAll(b *testing.B) {
-	benchRoutes(b, githubEcho, githubAPI)
-}
-func BenchmarkGin_GithubAll(b *testing.B) {
-	benchRoutes(b, githubGin, githubAPI)
-}
-func BenchmarkGoJsonRest_GithubAll(b *testing.B) {
-	benchRoutes(b, githubGoJsonRest, githubAPI)
-}
-func BenchmarkGorillaMux_GithubAll(b *testing.B) {
-	benchRoutes(b, githubGorillaMux, githubAPI)
-}
-func BenchmarkHttpRouter_GithubAll(b *testing.B) {
-	benchRoutes(b, githubHttpRouter, githubAPI)
-}
-func BenchmarkHttpTreeMux_GithubAll(b *testing.B) {
-	benchRoutes(b, githubHttpTreeMux, githubAPI)
-}
-func BenchmarkLARS_GithubAll(b *testing.B) {
-	benchRoutes(b, githubLARS, githubAPI)
-}
-func BenchmarkMartini_GithubAll(b *testing.B) {
-	benchRoutes(b, githubMartini, githubAPI)
-}
-func BenchmarkPossum_GithubAll(b *testing.B) {
-	benchRoutes(b, githubPossum, githubAPI)
-}
-func BenchmarkR2router_GithubAll(b *testing.B) {
-	benchRoutes(b, githubR2router, githubAPI)
-}
-func BenchmarkRivet_GithubAll(b *testing.B) {
-	benchRoutes(b, githubRivet, githubAPI)
-}
-func BenchmarkVulcan_GithubAll(b *testing.B) {
-	benchRoutes(b, githubVulcan, githubAPI)
-}
-
-// func BenchmarkZeus_GithubAll(b *testing.B) {
-// 	benchRoutes(b, githubZeus, githubAPI)
-// }
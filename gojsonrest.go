@@ -0,0 +1,84 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nogojsonrest
+// +build !nogojsonrest
+
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/ant0ine/go-json-rest/rest"
+)
+
+var _ = registerRouter(benchRouter{name: "GoJsonRest", pkg: "github.com/ant0ine/go-json-rest/rest", load: loadGoJsonRest})
+
+// go-json-rest/rest
+func goJsonRestHandler(w rest.ResponseWriter, req *rest.Request) {}
+
+func goJsonRestHandlerWrite(w rest.ResponseWriter, req *rest.Request) {
+	io.WriteString(w.(io.Writer), req.PathParam("name"))
+}
+
+func goJsonRestHandlerTest(w rest.ResponseWriter, req *rest.Request) {
+	io.WriteString(w.(io.Writer), req.RequestURI)
+}
+
+func goJsonRestMiddleware(h rest.HandlerFunc) rest.HandlerFunc {
+	return func(w rest.ResponseWriter, req *rest.Request) {
+		middlewareCalls++
+		h(w, req)
+	}
+}
+
+func goJsonRestHandlerParams(names []string) rest.HandlerFunc {
+	return func(w rest.ResponseWriter, req *rest.Request) {
+		for _, name := range names {
+			io.WriteString(w.(io.Writer), req.PathParam(name))
+			io.WriteString(w.(io.Writer), "\n")
+		}
+	}
+}
+
+func loadGoJsonRest(routes []route) http.Handler {
+	var h rest.HandlerFunc = goJsonRestHandler
+	if loadTestHandler {
+		h = goJsonRestHandlerTest
+	}
+
+	api := rest.NewApi()
+	restRoutes := make([]*rest.Route, 0, len(routes))
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = goJsonRestHandlerParams(paramNames(route.path))
+		}
+		restRoutes = append(restRoutes,
+			&rest.Route{route.method, route.path, h},
+		)
+	}
+	router, err := rest.MakeRouter(restRoutes...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < loadMiddlewares; i++ {
+		api.Use(rest.MiddlewareSimple(goJsonRestMiddleware))
+	}
+	api.SetApp(router)
+	return api.MakeHandler()
+}
+
+func loadGoJsonRestSingle(method, path string, hfunc rest.HandlerFunc) http.Handler {
+	api := rest.NewApi()
+	router, err := rest.MakeRouter(
+		&rest.Route{method, path, hfunc},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	api.SetApp(router)
+	return api.MakeHandler()
+}
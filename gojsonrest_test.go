@@ -0,0 +1,242 @@
+//go:build !nogojsonrest
+// +build !nogojsonrest
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkGoJsonRest_Param(b *testing.B) {
+	router := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkGoJsonRest_Param5(b *testing.B) {
+	handler := loadGoJsonRestSingle("GET", fiveColon, goJsonRestHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, handler, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkGoJsonRest_Param20(b *testing.B) {
+	handler := loadGoJsonRestSingle("GET", twentyColon, goJsonRestHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, handler, r)
+}
+
+// Route with Param and write
+func BenchmarkGoJsonRest_ParamWrite(b *testing.B) {
+	handler := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, handler, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkGoJsonRest_Param5Write(b *testing.B) {
+	router := loadGoJsonRestSingle("GET", fiveColon, goJsonRestHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkGoJsonRest_Param20Write(b *testing.B) {
+	router := loadGoJsonRestSingle("GET", twentyColon, goJsonRestHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkGoJsonRest_ParamEncodedWrite(b *testing.B) {
+	router := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkGoJsonRest_ParamSlashWrite(b *testing.B) {
+	router := loadGoJsonRestSingle("GET", "/user/:name", goJsonRestHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkGoJsonRest_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["GoJsonRest"], req)
+}
+
+// Param
+func BenchmarkGoJsonRest_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["GoJsonRest"], req)
+}
+
+// Param and write all params
+func BenchmarkGoJsonRest_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadGoJsonRest, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkGoJsonRest_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["GoJsonRest"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkGoJsonRest_GithubLoad(b *testing.B) {
+	benchLoad(b, loadGoJsonRest, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkGoJsonRest_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["GoJsonRest"], req)
+}
+
+// One Param
+func BenchmarkGoJsonRest_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["GoJsonRest"], req)
+}
+
+// Two Params
+func BenchmarkGoJsonRest_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["GoJsonRest"], req)
+}
+
+// One Param and write
+func BenchmarkGoJsonRest_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadGoJsonRest, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkGoJsonRest_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadGoJsonRest, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkGoJsonRest_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["GoJsonRest"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkGoJsonRest_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadGoJsonRest, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkGoJsonRest_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["GoJsonRest"], req)
+}
+
+// One Param
+func BenchmarkGoJsonRest_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["GoJsonRest"], req)
+}
+
+// Two Params
+func BenchmarkGoJsonRest_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["GoJsonRest"], req)
+}
+
+// All Routes
+func BenchmarkGoJsonRest_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["GoJsonRest"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkGoJsonRest_ParseLoad(b *testing.B) {
+	benchLoad(b, loadGoJsonRest, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkGoJsonRest_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["GoJsonRest"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkGoJsonRest_StaticLoad(b *testing.B) {
+	benchLoad(b, loadGoJsonRest, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkGoJsonRest_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["GoJsonRest"], req)
+}
+
+// Double slash
+func BenchmarkGoJsonRest_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["GoJsonRest"], req)
+}
+
+// Dot segment
+func BenchmarkGoJsonRest_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["GoJsonRest"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkGoJsonRest_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadGoJsonRest, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkGoJsonRest_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadGoJsonRest, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkGoJsonRest_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadGoJsonRest, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkGoJsonRest_GithubReload(b *testing.B) {
+	benchReload(b, loadGoJsonRest, githubAPI)
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nogorillamux
+// +build !nogorillamux
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+
+	"github.com/gorilla/mux"
+)
+
+var _ = registerRouter(benchRouter{
+	name:            "GorillaMux",
+	pkg:             "github.com/gorilla/mux",
+	load:            loadGorillaMux,
+	loadConstrained: loadGorillaMuxConstrained,
+	constrainQuery:  true,
+	loadGroups:      loadGorillaMuxGroups,
+	hosts:           true,
+	patterns:        true,
+})
+
+// gorilla/mux
+func gorillaHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	io.WriteString(w, params["name"])
+}
+
+func gorillaHandlerParams(names []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := mux.Vars(r)
+		for _, name := range names {
+			io.WriteString(w, params[name])
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadGorillaMux(routes []route) http.Handler {
+	hostRe := regexp.MustCompile(":([^.]*)")
+	m := mux.NewRouter()
+	for _, route := range routes {
+		var h http.HandlerFunc = httpHandlerFunc
+		if loadTestHandler {
+			h = httpHandlerFuncTest
+			if route.host != "" {
+				h = httpHandlerFuncTestLabel(route.host)
+			}
+		}
+		if loadParamsHandler {
+			h = gorillaHandlerParams(append(hostParamNames(route.host), paramNames(route.path)...))
+		}
+
+		path := patternPath(route, func(name, pattern string) string {
+			if pattern == "" {
+				return "{" + name + "}"
+			}
+			return "{" + name + ":" + pattern + "}"
+		})
+		r := m.HandleFunc(path, h).Methods(route.method)
+		if route.host != "" {
+			r.Host(hostRe.ReplaceAllString(route.host, "{$1}"))
+		}
+	}
+	return httpMiddlewares(m)
+}
+
+func loadGorillaMuxSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	m := mux.NewRouter()
+	m.HandleFunc(path, handler).Methods(method)
+	return m
+}
+
+func loadGorillaMuxGroups(groups []routeGroup) http.Handler {
+	re := regexp.MustCompile(":([^/]*)")
+	m := mux.NewRouter()
+
+	var add func(parent *mux.Router, prefix string, group routeGroup)
+	add = func(parent *mux.Router, prefix string, group routeGroup) {
+		sub := parent.PathPrefix(re.ReplaceAllString(group.prefix, "{$1}")).Subrouter()
+		prefix += group.prefix
+
+		for _, route := range group.routes {
+			var h http.HandlerFunc = httpHandlerFunc
+			if loadTestHandler {
+				h = httpHandlerFuncTest
+			}
+			if loadParamsHandler {
+				h = gorillaHandlerParams(paramNames(prefix + route.path))
+			}
+			sub.HandleFunc(re.ReplaceAllString(route.path, "{$1}"), h).Methods(route.method)
+		}
+		for _, g := range group.groups {
+			add(sub, prefix, g)
+		}
+	}
+	for _, group := range groups {
+		add(m, "", group)
+	}
+	return m
+}
+
+func loadGorillaMuxConstrained(routes []constrainedRoute) http.Handler {
+	re := regexp.MustCompile(":([^/]*)")
+	m := mux.NewRouter()
+	for _, route := range routes {
+		var h http.HandlerFunc = httpHandlerFunc
+		if loadTestHandler {
+			h = httpHandlerFuncTestLabel(route.constraint())
+		}
+
+		r := m.HandleFunc(re.ReplaceAllString(route.path, "{$1}"), h).Methods(route.method)
+		if route.header != "" {
+			r.Headers(route.header, route.value)
+		}
+		if route.query != "" {
+			r.Queries(route.query, route.value)
+		}
+	}
+	return m
+}
@@ -0,0 +1,322 @@
+//go:build !nogorillamux
+// +build !nogorillamux
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkGorillaMux_Param(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", "/user/{name}", httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkGorillaMux_Param5(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", fiveBrace, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkGorillaMux_Param20(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", twentyBrace, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkGorillaMux_ParamWrite(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", "/user/{name}", gorillaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkGorillaMux_Param5Write(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", fiveBrace, gorillaHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkGorillaMux_Param20Write(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", twentyBrace, gorillaHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkGorillaMux_ParamEncodedWrite(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", "/user/{name}", gorillaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkGorillaMux_ParamSlashWrite(b *testing.B) {
+	router := loadGorillaMuxSingle("GET", "/user/{name}", gorillaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkGorillaMux_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["GorillaMux"], req)
+}
+
+// Param
+func BenchmarkGorillaMux_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["GorillaMux"], req)
+}
+
+// Param and write all params
+func BenchmarkGorillaMux_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadGorillaMux, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkGorillaMux_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["GorillaMux"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkGorillaMux_GithubLoad(b *testing.B) {
+	benchLoad(b, loadGorillaMux, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkGorillaMux_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["GorillaMux"], req)
+}
+
+// One Param
+func BenchmarkGorillaMux_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["GorillaMux"], req)
+}
+
+// Two Params
+func BenchmarkGorillaMux_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["GorillaMux"], req)
+}
+
+// One Param and write
+func BenchmarkGorillaMux_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadGorillaMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkGorillaMux_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadGorillaMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkGorillaMux_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["GorillaMux"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkGorillaMux_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadGorillaMux, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkGorillaMux_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["GorillaMux"], req)
+}
+
+// One Param
+func BenchmarkGorillaMux_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["GorillaMux"], req)
+}
+
+// Two Params
+func BenchmarkGorillaMux_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["GorillaMux"], req)
+}
+
+// All Routes
+func BenchmarkGorillaMux_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["GorillaMux"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkGorillaMux_ParseLoad(b *testing.B) {
+	benchLoad(b, loadGorillaMux, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkGorillaMux_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["GorillaMux"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkGorillaMux_StaticLoad(b *testing.B) {
+	benchLoad(b, loadGorillaMux, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkGorillaMux_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["GorillaMux"], req)
+}
+
+// Double slash
+func BenchmarkGorillaMux_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["GorillaMux"], req)
+}
+
+// Dot segment
+func BenchmarkGorillaMux_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["GorillaMux"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkGorillaMux_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadGorillaMux, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkGorillaMux_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadGorillaMux, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkGorillaMux_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadGorillaMux, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkGorillaMux_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["GorillaMux"], req)
+}
+
+// All routes
+func BenchmarkGorillaMux_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["GorillaMux"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkGorillaMux_GithubReload(b *testing.B) {
+	benchReload(b, loadGorillaMux, githubAPI)
+}
+
+// Host matching, see tenant_test.go
+
+// Param on the main domain
+func BenchmarkGorillaMux_TenantMainParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "http://api.example.com/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, tenantRouters["GorillaMux"], req)
+}
+
+// Param on a tenant subdomain
+func BenchmarkGorillaMux_TenantParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "http://acme.api.example.com/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, tenantRouters["GorillaMux"], req)
+}
+
+// All routes
+func BenchmarkGorillaMux_TenantAll(b *testing.B) {
+	benchHostRoutes(b, tenantRouters["GorillaMux"], tenantAPI)
+}
+
+// Header and query routing, see constrained_test.go
+
+// Param with header
+func BenchmarkGorillaMux_HeaderParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	benchRequest(b, headerRouters["GorillaMux"], req)
+}
+
+// All routes with header
+func BenchmarkGorillaMux_HeaderAll(b *testing.B) {
+	benchConstrainedRoutes(b, headerRouters["GorillaMux"], headerAPI)
+}
+
+// Param with query parameter
+func BenchmarkGorillaMux_QueryParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers?per_page=100", nil)
+	benchRequest(b, queryRouters["GorillaMux"], req)
+}
+
+// All routes with query parameter
+func BenchmarkGorillaMux_QueryAll(b *testing.B) {
+	benchConstrainedRoutes(b, queryRouters["GorillaMux"], queryAPI)
+}
+
+// Parameter patterns, see pattern_test.go
+
+// Param matching a pattern
+func BenchmarkGorillaMux_PatternParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/go-http/routing/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", nil)
+	benchRequest(b, patternRouters["GorillaMux"], req)
+}
+
+// All routes, with values matching the patterns
+func BenchmarkGorillaMux_PatternAll(b *testing.B) {
+	benchRoutes(b, patternRouters["GorillaMux"], patternRequests)
+}
+
+// The same requests without patterns, for comparison
+func BenchmarkGorillaMux_PatternAllUnconstrained(b *testing.B) {
+	benchRoutes(b, githubRouters["GorillaMux"], patternRequests)
+}
+
+// All routes, each with one value not matching its pattern
+func BenchmarkGorillaMux_PatternRejected(b *testing.B) {
+	benchRoutes(b, patternRouters["GorillaMux"], patternRejected)
+}
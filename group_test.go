@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// The GitHub API in a group per first path segment, with the routes of a
+// repository in a nested group /:owner/:repo of the group /repos
+var githubGroups = groupRoutes(githubAPI, "/repos/:owner/:repo")
+
+// groupRoutes puts the routes in a group per first path segment, e.g. /user,
+// and the routes below one of the nested prefixes in a nested group of it.
+func groupRoutes(routes []route, nested ...string) []routeGroup {
+	var groups []routeGroup
+	index := make(map[string]int)
+	for _, r := range routes {
+		prefix := r.path
+		if i := strings.IndexByte(r.path[1:], '/'); i >= 0 {
+			prefix = r.path[:i+1]
+		}
+		i, ok := index[prefix]
+		if !ok {
+			i = len(groups)
+			index[prefix] = i
+			groups = append(groups, routeGroup{prefix: prefix})
+		}
+		group := &groups[i]
+		full := prefix
+
+		for _, n := range nested {
+			if strings.HasPrefix(n, prefix+"/") && (r.path == n || strings.HasPrefix(r.path, n+"/")) {
+				sub := n[len(prefix):]
+				if len(group.groups) == 0 || group.groups[len(group.groups)-1].prefix != sub {
+					group.groups = append(group.groups, routeGroup{prefix: sub})
+				}
+				group = &group.groups[len(group.groups)-1]
+				full = n
+				break
+			}
+		}
+		group.routes = append(group.routes, route{method: r.method, path: r.path[len(full):]})
+	}
+	return groups
+}
+
+// the GitHub API in groups loaded into every router which can register routes
+// in groups, see benchRouter.loadGroups, by name
+var groupRouters = make(map[string]http.Handler)
+
+func init() {
+	println("#GithubAPI Routes in Groups:", len(githubAPI))
+
+	for _, router := range routers {
+		if router.loadGroups != nil {
+			calcMem(router.name, func() {
+				groupRouters[router.name] = router.loadGroups(githubGroups)
+			})
+		}
+	}
+
+	println()
+}
+
+func TestGroupRouters(t *testing.T) {
+	loadParamsHandler = true
+
+	for _, router := range routers {
+		if router.loadGroups == nil {
+			continue
+		}
+		r := router.loadGroups(githubGroups)
+
+		for _, route := range githubAPI {
+			path, expected := paramRequest(route.path)
+			req := newRawRequest(route.method, path)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != expected {
+				t.Errorf(
+					"%s: %d - %q; expected %q for %s %s\n",
+					router.name, w.Code, w.Body.String(), expected, route.method, path,
+				)
+			}
+		}
+	}
+
+	loadParamsHandler = false
+}
@@ -0,0 +1,63 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nohttprouter
+// +build !nohttprouter
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+var _ = registerRouter(benchRouter{name: "HttpRouter", pkg: "github.com/julienschmidt/httprouter", load: loadHttpRouter})
+
+// HttpRouter
+func httpRouterHandle(_ http.ResponseWriter, _ *http.Request, _ httprouter.Params) {}
+
+func httpRouterHandleWrite(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+	io.WriteString(w, ps.ByName("name"))
+}
+
+func httpRouterHandleTest(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func httpRouterHandleParams(names []string) httprouter.Handle {
+	return func(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+		for _, name := range names {
+			io.WriteString(w, ps.ByName(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadHttpRouter(routes []route) http.Handler {
+	var h httprouter.Handle = httpRouterHandle
+	if loadTestHandler {
+		h = httpRouterHandleTest
+	}
+
+	router := httprouter.New()
+	if loadCaseInsensitive {
+		// redirects to the path with the right case, enabled by default
+		router.RedirectFixedPath = true
+	}
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = httpRouterHandleParams(paramNames(route.path))
+		}
+		router.Handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadHttpRouterSingle(method, path string, handle httprouter.Handle) http.Handler {
+	router := httprouter.New()
+	router.Handle(method, path, handle)
+	return router
+}
@@ -0,0 +1,252 @@
+//go:build !nohttprouter
+// +build !nohttprouter
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkHttpRouter_Param(b *testing.B) {
+	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandle)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkHttpRouter_Param5(b *testing.B) {
+	router := loadHttpRouterSingle("GET", fiveColon, httpRouterHandle)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkHttpRouter_Param20(b *testing.B) {
+	router := loadHttpRouterSingle("GET", twentyColon, httpRouterHandle)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkHttpRouter_ParamWrite(b *testing.B) {
+	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkHttpRouter_Param5Write(b *testing.B) {
+	router := loadHttpRouterSingle("GET", fiveColon, httpRouterHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkHttpRouter_Param20Write(b *testing.B) {
+	router := loadHttpRouterSingle("GET", twentyColon, httpRouterHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkHttpRouter_ParamEncodedWrite(b *testing.B) {
+	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkHttpRouter_ParamSlashWrite(b *testing.B) {
+	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkHttpRouter_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["HttpRouter"], req)
+}
+
+// Param
+func BenchmarkHttpRouter_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["HttpRouter"], req)
+}
+
+// Param and write all params
+func BenchmarkHttpRouter_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadHttpRouter, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkHttpRouter_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["HttpRouter"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpRouter_GithubLoad(b *testing.B) {
+	benchLoad(b, loadHttpRouter, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkHttpRouter_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["HttpRouter"], req)
+}
+
+// One Param
+func BenchmarkHttpRouter_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["HttpRouter"], req)
+}
+
+// Two Params
+func BenchmarkHttpRouter_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["HttpRouter"], req)
+}
+
+// One Param and write
+func BenchmarkHttpRouter_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadHttpRouter, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkHttpRouter_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadHttpRouter, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkHttpRouter_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["HttpRouter"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpRouter_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadHttpRouter, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkHttpRouter_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["HttpRouter"], req)
+}
+
+// One Param
+func BenchmarkHttpRouter_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["HttpRouter"], req)
+}
+
+// Two Params
+func BenchmarkHttpRouter_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["HttpRouter"], req)
+}
+
+// All Routes
+func BenchmarkHttpRouter_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["HttpRouter"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpRouter_ParseLoad(b *testing.B) {
+	benchLoad(b, loadHttpRouter, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkHttpRouter_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["HttpRouter"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkHttpRouter_StaticLoad(b *testing.B) {
+	benchLoad(b, loadHttpRouter, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkHttpRouter_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["HttpRouter"], req)
+}
+
+// Double slash
+func BenchmarkHttpRouter_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["HttpRouter"], req)
+}
+
+// Dot segment
+func BenchmarkHttpRouter_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["HttpRouter"], req)
+}
+
+// Case-insensitive lookup, see case_test.go
+
+// Case variant, with the routers configured to look up paths
+// case-insensitively
+func BenchmarkHttpRouter_GithubCaseInsensitive(b *testing.B) {
+	router := loadCaseInsensitiveRouter(loadHttpRouter, githubAPI)
+	req, _ := http.NewRequest("GET", "/Users/julienschmidt/Repos", nil)
+	benchVariant(b, router, req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkHttpRouter_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpRouter, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkHttpRouter_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpRouter, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkHttpRouter_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpRouter, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkHttpRouter_GithubReload(b *testing.B) {
+	benchReload(b, loadHttpRouter, githubAPI)
+}
@@ -0,0 +1,108 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nohttptreemux
+// +build !nohttptreemux
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/dimfeld/httptreemux"
+)
+
+var _ = registerRouter(benchRouter{
+	name:       "HttpTreeMux",
+	pkg:        "github.com/dimfeld/httptreemux",
+	load:       loadHttpTreeMux,
+	loadGroups: loadHttpTreeMuxGroups,
+	loadLive:   loadHttpTreeMuxLive,
+})
+
+// httpTreeMux
+func httpTreeMuxHandler(_ http.ResponseWriter, _ *http.Request, _ map[string]string) {}
+
+func httpTreeMuxHandlerWrite(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	io.WriteString(w, vars["name"])
+}
+
+func httpTreeMuxHandlerTest(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func httpTreeMuxHandlerParams(names []string) httptreemux.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+		for _, name := range names {
+			io.WriteString(w, vars[name])
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadHttpTreeMux(routes []route) http.Handler {
+	var h httptreemux.HandlerFunc = httpTreeMuxHandler
+	if loadTestHandler {
+		h = httpTreeMuxHandlerTest
+	}
+
+	router := httptreemux.New()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = httpTreeMuxHandlerParams(paramNames(route.path))
+		}
+		router.Handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadHttpTreeMuxSingle(method, path string, handler httptreemux.HandlerFunc) http.Handler {
+	router := httptreemux.New()
+	router.Handle(method, path, handler)
+	return router
+}
+
+// loadHttpTreeMuxLive returns a router and a function to add routes to it,
+// which is safe while the router serves requests.
+func loadHttpTreeMuxLive() (http.Handler, func(route route)) {
+	var h httptreemux.HandlerFunc = httpTreeMuxHandler
+	if loadTestHandler {
+		h = httpTreeMuxHandlerTest
+	}
+
+	router := httptreemux.New()
+	router.SafeAddRoutesWhileRunning = true
+	return router, func(route route) {
+		router.Handle(route.method, route.path, h)
+	}
+}
+
+func loadHttpTreeMuxGroups(groups []routeGroup) http.Handler {
+	router := httptreemux.New()
+
+	var add func(parent *httptreemux.Group, prefix string, group routeGroup)
+	add = func(parent *httptreemux.Group, prefix string, group routeGroup) {
+		g := parent.NewGroup(group.prefix)
+		prefix += group.prefix
+
+		for _, route := range group.routes {
+			var h httptreemux.HandlerFunc = httpTreeMuxHandler
+			if loadTestHandler {
+				h = httpTreeMuxHandlerTest
+			}
+			if loadParamsHandler {
+				h = httpTreeMuxHandlerParams(paramNames(prefix + route.path))
+			}
+			g.Handle(route.method, route.path, h)
+		}
+		for _, sub := range group.groups {
+			add(g, prefix, sub)
+		}
+	}
+	for _, group := range groups {
+		add(&router.Group, "", group)
+	}
+	return router
+}
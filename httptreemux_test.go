@@ -0,0 +1,260 @@
+//go:build !nohttptreemux
+// +build !nohttptreemux
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkHttpTreeMux_Param(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkHttpTreeMux_Param5(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", fiveColon, httpTreeMuxHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkHttpTreeMux_Param20(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", twentyColon, httpTreeMuxHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkHttpTreeMux_ParamWrite(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkHttpTreeMux_Param5Write(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", fiveColon, httpTreeMuxHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkHttpTreeMux_Param20Write(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", twentyColon, httpTreeMuxHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkHttpTreeMux_ParamEncodedWrite(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkHttpTreeMux_ParamSlashWrite(b *testing.B) {
+	router := loadHttpTreeMuxSingle("GET", "/user/:name", httpTreeMuxHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkHttpTreeMux_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["HttpTreeMux"], req)
+}
+
+// Param
+func BenchmarkHttpTreeMux_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["HttpTreeMux"], req)
+}
+
+// Param and write all params
+func BenchmarkHttpTreeMux_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadHttpTreeMux, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkHttpTreeMux_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["HttpTreeMux"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpTreeMux_GithubLoad(b *testing.B) {
+	benchLoad(b, loadHttpTreeMux, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkHttpTreeMux_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["HttpTreeMux"], req)
+}
+
+// One Param
+func BenchmarkHttpTreeMux_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["HttpTreeMux"], req)
+}
+
+// Two Params
+func BenchmarkHttpTreeMux_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["HttpTreeMux"], req)
+}
+
+// One Param and write
+func BenchmarkHttpTreeMux_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadHttpTreeMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkHttpTreeMux_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadHttpTreeMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkHttpTreeMux_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["HttpTreeMux"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpTreeMux_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadHttpTreeMux, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkHttpTreeMux_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["HttpTreeMux"], req)
+}
+
+// One Param
+func BenchmarkHttpTreeMux_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["HttpTreeMux"], req)
+}
+
+// Two Params
+func BenchmarkHttpTreeMux_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["HttpTreeMux"], req)
+}
+
+// All Routes
+func BenchmarkHttpTreeMux_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["HttpTreeMux"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkHttpTreeMux_ParseLoad(b *testing.B) {
+	benchLoad(b, loadHttpTreeMux, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkHttpTreeMux_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["HttpRouter"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkHttpTreeMux_StaticLoad(b *testing.B) {
+	benchLoad(b, loadHttpTreeMux, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkHttpTreeMux_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["HttpTreeMux"], req)
+}
+
+// Double slash
+func BenchmarkHttpTreeMux_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["HttpTreeMux"], req)
+}
+
+// Dot segment
+func BenchmarkHttpTreeMux_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["HttpTreeMux"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkHttpTreeMux_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpTreeMux, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkHttpTreeMux_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpTreeMux, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkHttpTreeMux_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpTreeMux, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkHttpTreeMux_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["HttpTreeMux"], req)
+}
+
+// All routes
+func BenchmarkHttpTreeMux_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["HttpTreeMux"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Adding all routes to the router while serving
+func BenchmarkHttpTreeMux_GithubRegister(b *testing.B) {
+	benchRegister(b, loadHttpTreeMuxLive, githubAPI)
+}
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkHttpTreeMux_GithubReload(b *testing.B) {
+	benchReload(b, loadHttpTreeMux, githubAPI)
+}
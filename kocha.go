@@ -0,0 +1,106 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nokocha
+// +build !nokocha
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	urlrouter "github.com/naoina/kocha-urlrouter"
+	_ "github.com/naoina/kocha-urlrouter/doublearray"
+)
+
+var _ = registerRouter(benchRouter{name: "Kocha", pkg: "github.com/naoina/kocha-urlrouter", load: loadKocha})
+
+// Kocha-urlrouter
+// The doublearray router only looks up paths, the kochaRouter wraps a router
+// per method in an http.Handler and hands the parameters to the handler.
+type kochaHandlerFunc func(http.ResponseWriter, *http.Request, []urlrouter.Param)
+
+type kochaRouter struct {
+	routerMap map[string]urlrouter.URLRouter
+}
+
+func (k *kochaRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	router, ok := k.routerMap[req.Method]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	h, params := router.Lookup(req.URL.Path)
+	if h == nil {
+		http.NotFound(w, req)
+		return
+	}
+	h.(kochaHandlerFunc)(w, req, params)
+}
+
+// kochaParam returns the value of the parameter with the name.
+func kochaParam(params []urlrouter.Param, name string) string {
+	for _, param := range params {
+		if param.Name == name {
+			return param.Value
+		}
+	}
+	return ""
+}
+
+func kochaHandler(_ http.ResponseWriter, _ *http.Request, _ []urlrouter.Param) {}
+
+func kochaHandlerWrite(w http.ResponseWriter, _ *http.Request, params []urlrouter.Param) {
+	io.WriteString(w, kochaParam(params, "name"))
+}
+
+func kochaHandlerTest(w http.ResponseWriter, r *http.Request, _ []urlrouter.Param) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func kochaHandlerParams(names []string) kochaHandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, params []urlrouter.Param) {
+		for _, name := range names {
+			io.WriteString(w, kochaParam(params, name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadKocha(routes []route) http.Handler {
+	var h kochaHandlerFunc = kochaHandler
+	if loadTestHandler {
+		h = kochaHandlerTest
+	}
+
+	records := make(map[string][]urlrouter.Record)
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = kochaHandlerParams(paramNames(route.path))
+		}
+		records[route.method] = append(records[route.method], urlrouter.NewRecord(route.path, h))
+	}
+
+	router := &kochaRouter{routerMap: make(map[string]urlrouter.URLRouter)}
+	for method, r := range records {
+		router.routerMap[method] = urlrouter.NewURLRouter("doublearray")
+		if err := router.routerMap[method].Build(r); err != nil {
+			panic(err)
+		}
+	}
+	return httpMiddlewares(router)
+}
+
+func loadKochaSingle(method, path string, handler kochaHandlerFunc) http.Handler {
+	router := &kochaRouter{routerMap: map[string]urlrouter.URLRouter{
+		method: urlrouter.NewURLRouter("doublearray"),
+	}}
+	if err := router.routerMap[method].Build([]urlrouter.Record{
+		urlrouter.NewRecord(path, handler),
+	}); err != nil {
+		panic(err)
+	}
+	return router
+}
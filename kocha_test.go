@@ -0,0 +1,242 @@
+//go:build !nokocha
+// +build !nokocha
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkKocha_Param(b *testing.B) {
+	router := loadKochaSingle("GET", "/user/:name", kochaHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkKocha_Param5(b *testing.B) {
+	router := loadKochaSingle("GET", fiveColon, kochaHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkKocha_Param20(b *testing.B) {
+	router := loadKochaSingle("GET", twentyColon, kochaHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkKocha_ParamWrite(b *testing.B) {
+	router := loadKochaSingle("GET", "/user/:name", kochaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkKocha_Param5Write(b *testing.B) {
+	router := loadKochaSingle("GET", fiveColon, kochaHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkKocha_Param20Write(b *testing.B) {
+	router := loadKochaSingle("GET", twentyColon, kochaHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkKocha_ParamEncodedWrite(b *testing.B) {
+	router := loadKochaSingle("GET", "/user/:name", kochaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkKocha_ParamSlashWrite(b *testing.B) {
+	router := loadKochaSingle("GET", "/user/:name", kochaHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkKocha_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Kocha"], req)
+}
+
+// Param
+func BenchmarkKocha_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Kocha"], req)
+}
+
+// Param and write all params
+func BenchmarkKocha_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadKocha, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkKocha_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Kocha"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkKocha_GithubLoad(b *testing.B) {
+	benchLoad(b, loadKocha, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkKocha_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Kocha"], req)
+}
+
+// One Param
+func BenchmarkKocha_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Kocha"], req)
+}
+
+// Two Params
+func BenchmarkKocha_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Kocha"], req)
+}
+
+// One Param and write
+func BenchmarkKocha_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadKocha, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkKocha_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadKocha, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkKocha_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Kocha"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkKocha_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadKocha, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkKocha_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Kocha"], req)
+}
+
+// One Param
+func BenchmarkKocha_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Kocha"], req)
+}
+
+// Two Params
+func BenchmarkKocha_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Kocha"], req)
+}
+
+// All Routes
+func BenchmarkKocha_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Kocha"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkKocha_ParseLoad(b *testing.B) {
+	benchLoad(b, loadKocha, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkKocha_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Kocha"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkKocha_StaticLoad(b *testing.B) {
+	benchLoad(b, loadKocha, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkKocha_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Kocha"], req)
+}
+
+// Double slash
+func BenchmarkKocha_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Kocha"], req)
+}
+
+// Dot segment
+func BenchmarkKocha_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Kocha"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkKocha_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadKocha, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkKocha_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadKocha, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkKocha_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadKocha, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkKocha_GithubReload(b *testing.B) {
+	benchReload(b, loadKocha, githubAPI)
+}
@@ -0,0 +1,147 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nolars
+// +build !nolars
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-playground/lars"
+)
+
+var _ = registerRouter(benchRouter{
+	name:       "LARS",
+	pkg:        "github.com/go-playground/lars",
+	load:       loadLARS,
+	loadGroups: loadLARSGroups,
+})
+
+// LARS
+func larsHandler(c lars.Context) {
+}
+
+func larsHandlerWrite(c lars.Context) {
+	io.WriteString(c.Response(), c.Param("name"))
+}
+
+func larsHandlerTest(c lars.Context) {
+	io.WriteString(c.Response(), c.Request().RequestURI)
+}
+
+func larsNativeHandlerTest(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func larsMiddleware(c lars.Context) {
+	middlewareCalls++
+	c.Next()
+}
+
+func larsHandlerParams(names []string) func(lars.Context) {
+	return func(c lars.Context) {
+		for _, name := range names {
+			io.WriteString(c.Response(), c.Param(name))
+			io.WriteString(c.Response(), "\n")
+		}
+	}
+}
+
+func loadLARS(routes []route) http.Handler {
+	var h interface{} = larsHandler
+	if loadTestHandler {
+		h = larsHandlerTest
+	}
+
+	l := lars.New()
+	// only applies to the routes added afterwards
+	for i := 0; i < loadMiddlewares; i++ {
+		l.Use(larsMiddleware)
+	}
+
+	for _, r := range routes {
+		if loadParamsHandler {
+			h = larsHandlerParams(paramNames(r.path))
+		}
+		switch r.method {
+		case "GET":
+			l.Get(r.path, h)
+		case "POST":
+			l.Post(r.path, h)
+		case "PUT":
+			l.Put(r.path, h)
+		case "PATCH":
+			l.Patch(r.path, h)
+		case "DELETE":
+			l.Delete(r.path, h)
+		default:
+			panic("Unknow HTTP method: " + r.method)
+		}
+	}
+	return l.Serve()
+}
+
+func loadLARSSingle(method, path string, h interface{}) http.Handler {
+	l := lars.New()
+
+	switch method {
+	case "GET":
+		l.Get(path, h)
+	case "POST":
+		l.Post(path, h)
+	case "PUT":
+		l.Put(path, h)
+	case "PATCH":
+		l.Patch(path, h)
+	case "DELETE":
+		l.Delete(path, h)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return l.Serve()
+}
+
+func loadLARSGroups(groups []routeGroup) http.Handler {
+	l := lars.New()
+
+	var add func(parent lars.IRouteGroup, prefix string, group routeGroup)
+	add = func(parent lars.IRouteGroup, prefix string, group routeGroup) {
+		g := parent.Group(group.prefix)
+		prefix += group.prefix
+
+		for _, r := range group.routes {
+			var h interface{} = larsHandler
+			if loadTestHandler {
+				h = larsHandlerTest
+			}
+			if loadParamsHandler {
+				h = larsHandlerParams(paramNames(prefix + r.path))
+			}
+			switch r.method {
+			case "GET":
+				g.Get(r.path, h)
+			case "POST":
+				g.Post(r.path, h)
+			case "PUT":
+				g.Put(r.path, h)
+			case "PATCH":
+				g.Patch(r.path, h)
+			case "DELETE":
+				g.Delete(r.path, h)
+			default:
+				panic("Unknow HTTP method: " + r.method)
+			}
+		}
+		for _, sub := range group.groups {
+			add(g, prefix, sub)
+		}
+	}
+	for _, group := range groups {
+		add(l, "", group)
+	}
+	return l.Serve()
+}
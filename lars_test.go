@@ -0,0 +1,255 @@
+//go:build !nolars
+// +build !nolars
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkLARS_Param(b *testing.B) {
+	router := loadLARSSingle("GET", "/user/:name", larsHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkLARS_Param5(b *testing.B) {
+	router := loadLARSSingle("GET", fiveColon, larsHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkLARS_Param20(b *testing.B) {
+	router := loadLARSSingle("GET", twentyColon, larsHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkLARS_ParamWrite(b *testing.B) {
+	router := loadLARSSingle("GET", "/user/:name", larsHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkLARS_Param5Write(b *testing.B) {
+	router := loadLARSSingle("GET", fiveColon, larsHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkLARS_Param20Write(b *testing.B) {
+	router := loadLARSSingle("GET", twentyColon, larsHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkLARS_ParamEncodedWrite(b *testing.B) {
+	router := loadLARSSingle("GET", "/user/:name", larsHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkLARS_ParamSlashWrite(b *testing.B) {
+	router := loadLARSSingle("GET", "/user/:name", larsHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkLARS_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["LARS"], req)
+}
+
+// Param
+func BenchmarkLARS_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["LARS"], req)
+}
+
+// Param and write all params
+func BenchmarkLARS_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadLARS, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkLARS_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["LARS"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkLARS_GithubLoad(b *testing.B) {
+	benchLoad(b, loadLARS, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkLARS_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["LARS"], req)
+}
+
+// One Param
+func BenchmarkLARS_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["LARS"], req)
+}
+
+// Two Params
+func BenchmarkLARS_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["LARS"], req)
+}
+
+// One Param and write
+func BenchmarkLARS_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadLARS, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkLARS_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadLARS, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkLARS_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["LARS"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkLARS_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadLARS, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkLARS_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["LARS"], req)
+}
+
+// One Param
+func BenchmarkLARS_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["LARS"], req)
+}
+
+// Two Params
+func BenchmarkLARS_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["LARS"], req)
+}
+
+// All Routes
+func BenchmarkLARS_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["LARS"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkLARS_ParseLoad(b *testing.B) {
+	benchLoad(b, loadLARS, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkLARS_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["LARS"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkLARS_StaticLoad(b *testing.B) {
+	benchLoad(b, loadLARS, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkLARS_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["LARS"], req)
+}
+
+// Double slash
+func BenchmarkLARS_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["LARS"], req)
+}
+
+// Dot segment
+func BenchmarkLARS_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["LARS"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkLARS_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadLARS, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkLARS_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadLARS, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkLARS_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadLARS, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Route groups, see group_test.go
+
+// Param in a nested group
+func BenchmarkLARS_GithubGroupsParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, groupRouters["LARS"], req)
+}
+
+// All routes
+func BenchmarkLARS_GithubGroupsAll(b *testing.B) {
+	benchRoutes(b, groupRouters["LARS"], githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkLARS_GithubReload(b *testing.B) {
+	benchReload(b, loadLARS, githubAPI)
+}
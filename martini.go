@@ -0,0 +1,111 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nomartini
+// +build !nomartini
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-martini/martini"
+)
+
+var _ = registerRouter(benchRouter{
+	name:     "Martini",
+	pkg:      "github.com/go-martini/martini",
+	load:     loadMartini,
+	patterns: true,
+})
+
+// Martini
+func martiniHandler() {}
+
+func martiniHandlerWrite(params martini.Params) string {
+	return params["name"]
+}
+
+func martiniMiddleware(c martini.Context) {
+	middlewareCalls++
+	c.Next()
+}
+
+func martiniHandlerParams(names []string) func(http.ResponseWriter, martini.Params) {
+	return func(w http.ResponseWriter, params martini.Params) {
+		for _, name := range names {
+			io.WriteString(w, params[name])
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func init() {
+	martini.Env = martini.Prod
+}
+
+func loadMartini(routes []route) http.Handler {
+	var h interface{} = martiniHandler
+	if loadTestHandler {
+		h = httpHandlerFuncTest
+	}
+
+	router := martini.NewRouter()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = martiniHandlerParams(paramNames(route.path))
+		}
+
+		// Martini takes regular expressions with named groups as routes
+		path := patternPath(route, func(name, pattern string) string {
+			if pattern == "" {
+				return ":" + name
+			}
+			return "(?P<" + name + ">" + pattern + ")"
+		})
+		switch route.method {
+		case "GET":
+			router.Get(path, h)
+		case "POST":
+			router.Post(path, h)
+		case "PUT":
+			router.Put(path, h)
+		case "PATCH":
+			router.Patch(path, h)
+		case "DELETE":
+			router.Delete(path, h)
+		default:
+			panic("Unknow HTTP method: " + route.method)
+		}
+	}
+	martini := martini.New()
+	for i := 0; i < loadMiddlewares; i++ {
+		martini.Use(martiniMiddleware)
+	}
+	martini.Action(router.Handle)
+	return martini
+}
+
+func loadMartiniSingle(method, path string, handler interface{}) http.Handler {
+	router := martini.NewRouter()
+	switch method {
+	case "GET":
+		router.Get(path, handler)
+	case "POST":
+		router.Post(path, handler)
+	case "PUT":
+		router.Put(path, handler)
+	case "PATCH":
+		router.Patch(path, handler)
+	case "DELETE":
+		router.Delete(path, handler)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+
+	martini := martini.New()
+	martini.Action(router.Handle)
+	return martini
+}
@@ -0,0 +1,265 @@
+//go:build !nomartini
+// +build !nomartini
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkMartini_Param(b *testing.B) {
+	router := loadMartiniSingle("GET", "/user/:name", martiniHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkMartini_Param5(b *testing.B) {
+	router := loadMartiniSingle("GET", fiveColon, martiniHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkMartini_Param20(b *testing.B) {
+	router := loadMartiniSingle("GET", twentyColon, martiniHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkMartini_ParamWrite(b *testing.B) {
+	router := loadMartiniSingle("GET", "/user/:name", martiniHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkMartini_Param5Write(b *testing.B) {
+	router := loadMartiniSingle("GET", fiveColon, martiniHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkMartini_Param20Write(b *testing.B) {
+	router := loadMartiniSingle("GET", twentyColon, martiniHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkMartini_ParamEncodedWrite(b *testing.B) {
+	router := loadMartiniSingle("GET", "/user/:name", martiniHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkMartini_ParamSlashWrite(b *testing.B) {
+	router := loadMartiniSingle("GET", "/user/:name", martiniHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkMartini_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Martini"], req)
+}
+
+// Param
+func BenchmarkMartini_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Martini"], req)
+}
+
+// Param and write all params
+func BenchmarkMartini_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadMartini, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkMartini_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Martini"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkMartini_GithubLoad(b *testing.B) {
+	benchLoad(b, loadMartini, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkMartini_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Martini"], req)
+}
+
+// One Param
+func BenchmarkMartini_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Martini"], req)
+}
+
+// Two Params
+func BenchmarkMartini_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Martini"], req)
+}
+
+// One Param and write
+func BenchmarkMartini_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadMartini, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkMartini_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadMartini, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkMartini_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Martini"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkMartini_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadMartini, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkMartini_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Martini"], req)
+}
+
+// One Param
+func BenchmarkMartini_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Martini"], req)
+}
+
+// Two Params
+func BenchmarkMartini_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Martini"], req)
+}
+
+// All Routes
+func BenchmarkMartini_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Martini"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkMartini_ParseLoad(b *testing.B) {
+	benchLoad(b, loadMartini, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkMartini_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Martini"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkMartini_StaticLoad(b *testing.B) {
+	benchLoad(b, loadMartini, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkMartini_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Martini"], req)
+}
+
+// Double slash
+func BenchmarkMartini_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Martini"], req)
+}
+
+// Dot segment
+func BenchmarkMartini_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Martini"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkMartini_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadMartini, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkMartini_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadMartini, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkMartini_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadMartini, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkMartini_GithubReload(b *testing.B) {
+	benchReload(b, loadMartini, githubAPI)
+}
+
+// Parameter patterns, see pattern_test.go
+
+// Param matching a pattern
+func BenchmarkMartini_PatternParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/go-http/routing/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", nil)
+	benchRequest(b, patternRouters["Martini"], req)
+}
+
+// All routes, with values matching the patterns
+func BenchmarkMartini_PatternAll(b *testing.B) {
+	benchRoutes(b, patternRouters["Martini"], patternRequests)
+}
+
+// The same requests without patterns, for comparison
+func BenchmarkMartini_PatternAllUnconstrained(b *testing.B) {
+	benchRoutes(b, githubRouters["Martini"], patternRequests)
+}
+
+// All routes, each with one value not matching its pattern
+func BenchmarkMartini_PatternRejected(b *testing.B) {
+	benchRoutes(b, patternRouters["Martini"], patternRejected)
+}
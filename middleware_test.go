@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// routers which add the no-op middlewares through their own middleware
+// mechanism, all others are wrapped with httpMiddlewares
+var nativeMiddlewareRouters = map[string]bool{
+	"Ace":        true,
+	"Bear":       true,
+	"Echo":       true,
+	"Gin":        true,
+	"GoJsonRest": true,
+	"LARS":       true,
+	"Martini":    true,
+}
+
+// loadMiddlewareRouter loads the routes with n no-op middlewares.
+func loadMiddlewareRouter(load func(routes []route) http.Handler, routes []route, n int) http.Handler {
+	loadMiddlewares = n
+	router := load(routes)
+	loadMiddlewares = 0
+	return router
+}
+
+func init() {
+	features = append(features, feature{
+		name: "Middlewares",
+		check: func(router string, _ func(routes []route) http.Handler) string {
+			if nativeMiddlewareRouters[router] {
+				return "native"
+			}
+			return "http.Handler"
+		},
+	})
+}
+
+// TestMiddlewares checks that every request passes all middlewares and still
+// reaches the handler of its route.
+func TestMiddlewares(t *testing.T) {
+	const n = 5
+	loadTestHandler = true
+
+	for _, router := range routers {
+		r := loadMiddlewareRouter(router.load, githubAPI, n)
+
+		for _, route := range githubAPI {
+			calls := middlewareCalls
+			req, _ := http.NewRequest(route.method, route.path, nil)
+			req.RequestURI = route.path
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != route.path {
+				t.Errorf(
+					"%s: %d - %s; expected %s %s\n",
+					router.name, w.Code, w.Body.String(), route.method, route.path,
+				)
+			}
+			if calls := middlewareCalls - calls; calls != n {
+				t.Errorf(
+					"%s: %s %s passed %d middlewares; expected %d\n",
+					router.name, route.method, route.path, calls, n,
+				)
+			}
+		}
+	}
+
+	loadTestHandler = false
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// routers which can not hand out route parameters in this suite
+var routersWithoutParams = map[string]string{
+	"BaselineDirect": "there is no routing, every request is handed to the same handler",
+	"Possum":         "routes are loaded with possum's Simple router, which does not extract parameters",
+	"Vulcan":         "mailgun/route does not expose route parameters to the handler",
+}
+
+// paramRequest returns a concrete path for a route path by replacing each
+// parameter with a distinct value, e.g. /repos/owner1/repo2 for
+// /repos/:owner/:repo, and the body the params handlers should write for it.
+func paramRequest(path string) (string, string) {
+	segments := strings.Split(path, "/")
+	body := ""
+	n := 0
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			n++
+			segments[i] = segment[1:] + strconv.Itoa(n)
+			body += segments[i] + "\n"
+		}
+	}
+	return strings.Join(segments, "/"), body
+}
+
+func TestRouterParams(t *testing.T) {
+	loadParamsHandler = true
+
+	for _, router := range routers {
+		if reason, ok := routersWithoutParams[router.name]; ok {
+			t.Logf("%s: skipped, %s", router.name, reason)
+			continue
+		}
+
+		for _, api := range apis {
+			r := router.load(api.routes)
+
+			for _, route := range api.routes {
+				path, expected := paramRequest(route.path)
+				req, _ := http.NewRequest(route.method, path, nil)
+				req.RequestURI = path
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				if w.Code != 200 || w.Body.String() != expected {
+					t.Errorf(
+						"%s in API %s: %d - %q; expected %q for %s %s (%s)\n",
+						router.name, api.name, w.Code, w.Body.String(), expected, route.method, path, route.path,
+					)
+				}
+			}
+		}
+	}
+
+	loadParamsHandler = false
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// parameter patterns
+const (
+	numericPattern = "[0-9]+"
+	shaPattern     = "[0-9a-f]{40}"
+	slugPattern    = "[a-z0-9][a-z0-9-]*"
+)
+
+// patterns of the parameters of the GitHub API, by name
+var githubPatterns = map[string]string{
+	"id":     numericPattern,
+	"number": numericPattern,
+	"sha":    shaPattern,
+	"owner":  slugPattern,
+	"repo":   slugPattern,
+	"user":   slugPattern,
+	"org":    slugPattern,
+}
+
+// a value matching and a value not matching each pattern
+var patternValues = map[string]struct {
+	valid, invalid string
+}{
+	numericPattern: {"1347", "v1"},
+	shaPattern:     {"6dcb09b5b57875f334f61aebed695e2e4193db5e", "master"},
+	slugPattern:    {"go-http", "Go_Http"},
+}
+
+// The GitHub API with the patterns of githubPatterns
+var patternAPI = func() []route {
+	routes := make([]route, len(githubAPI))
+	for i, r := range githubAPI {
+		r.patterns = make(map[string]string)
+		for _, name := range paramNames(r.path) {
+			if pattern, ok := githubPatterns[name]; ok {
+				r.patterns[name] = pattern
+			}
+		}
+		routes[i] = r
+	}
+	return routes
+}()
+
+var (
+	// the pattern API loaded into every router which can match parameters
+	// with regular expressions, see benchRouter.patterns, by name. Vulcan
+	// only knows <int:name>, Echo and Bear only match any value.
+	patternRouters = make(map[string]http.Handler)
+
+	// concrete requests for all routes, matching the patterns or with the
+	// name of the parameter followed by 1
+	patternRequests, patternRejected = patternPaths(patternAPI)
+)
+
+func init() {
+	println("#Pattern Routes:", len(patternAPI))
+
+	for _, router := range routers {
+		if router.patterns {
+			calcMem(router.name, func() {
+				patternRouters[router.name] = router.load(patternAPI)
+			})
+		}
+	}
+
+	println()
+
+	features = append(features, feature{
+		name: "Parameter patterns",
+		check: func(router string, _ func(routes []route) http.Handler) string {
+			if r := findRouter(router); r != nil && r.patterns {
+				return "yes"
+			}
+			if router == "Vulcan" {
+				return "int only"
+			}
+			return "unsupported"
+		},
+	})
+}
+
+// patternRequest returns a concrete path for the route and the parameters the
+// params handlers write for it. The parameter invalid, if any, gets a value
+// not matching its pattern.
+func patternRequest(route route, invalid string) (path, params string) {
+	segments := strings.Split(route.path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") {
+			continue
+		}
+		name := segment[1:]
+		value := name + "1"
+		if pattern, ok := route.patterns[name]; ok {
+			value = patternValues[pattern].valid
+			if name == invalid {
+				value = patternValues[pattern].invalid
+			}
+		}
+		segments[i] = value
+		params += value + "\n"
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// patternPaths returns a request for every route, with values matching the
+// patterns, and a request for every pattern of every route, with a value not
+// matching it.
+func patternPaths(routes []route) (valid, rejected []route) {
+	for _, r := range routes {
+		path, _ := patternRequest(r, "")
+		valid = append(valid, route{method: r.method, path: path})
+		for _, name := range paramNames(r.path) {
+			if _, ok := r.patterns[name]; ok {
+				path, _ := patternRequest(r, name)
+				rejected = append(rejected, route{method: r.method, path: path})
+			}
+		}
+	}
+	return valid, rejected
+}
+
+func TestPatternRouters(t *testing.T) {
+	loadParamsHandler = true
+
+	for _, router := range routers {
+		if !router.patterns {
+			continue
+		}
+		r := router.load(patternAPI)
+
+		for _, route := range patternAPI {
+			path, expected := patternRequest(route, "")
+			req := newRawRequest(route.method, path)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != expected {
+				t.Errorf(
+					"%s: %d - %q; expected %q for %s %s\n",
+					router.name, w.Code, w.Body.String(), expected, route.method, path,
+				)
+			}
+
+			// other routes may match the path, but this one must not
+			for name := range route.patterns {
+				path, params := patternRequest(route, name)
+				req := newRawRequest(route.method, path)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				if w.Code == 200 && w.Body.String() == params {
+					t.Errorf(
+						"%s: %s %s matched %s although %s does not match %s\n",
+						router.name, route.method, path, route.path, name, route.patterns[name],
+					)
+				}
+			}
+		}
+	}
+
+	loadParamsHandler = false
+}
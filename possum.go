@@ -0,0 +1,53 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nopossum
+// +build !nopossum
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/mikespook/possum"
+	possumrouter "github.com/mikespook/possum/router"
+	possumview "github.com/mikespook/possum/view"
+)
+
+var _ = registerRouter(benchRouter{name: "Possum", pkg: "github.com/mikespook/possum", load: loadPossum})
+
+// Possum
+func possumHandler(c *possum.Context) error {
+	return nil
+}
+
+func possumHandlerWrite(c *possum.Context) error {
+	io.WriteString(c.Response, c.Request.URL.Query().Get("name"))
+	return nil
+}
+
+func possumHandlerTest(c *possum.Context) error {
+	io.WriteString(c.Response, c.Request.RequestURI)
+	return nil
+}
+
+func loadPossum(routes []route) http.Handler {
+	h := possumHandler
+	if loadTestHandler {
+		h = possumHandlerTest
+	}
+
+	router := possum.NewServerMux()
+	for _, route := range routes {
+		router.HandleFunc(possumrouter.Simple(route.path), h, possumview.Simple("text/html", "utf-8"))
+	}
+	return httpMiddlewares(router)
+}
+
+func loadPossumSingle(method, path string, handler possum.HandlerFunc) http.Handler {
+	router := possum.NewServerMux()
+	router.HandleFunc(possumrouter.Simple(path), handler, possumview.Simple("text/html", "utf-8"))
+	return router
+}
@@ -0,0 +1,205 @@
+//go:build !nopossum
+// +build !nopossum
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkPossum_Param(b *testing.B) {
+	router := loadPossumSingle("GET", "/user/:name", possumHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkPossum_Param5(b *testing.B) {
+	router := loadPossumSingle("GET", fiveColon, possumHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkPossum_Param20(b *testing.B) {
+	router := loadPossumSingle("GET", twentyColon, possumHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkPossum_ParamWrite(b *testing.B) {
+	router := loadPossumSingle("GET", "/user/:name", possumHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkPossum_ParamEncodedWrite(b *testing.B) {
+	router := loadPossumSingle("GET", "/user/:name", possumHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkPossum_ParamSlashWrite(b *testing.B) {
+	router := loadPossumSingle("GET", "/user/:name", possumHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkPossum_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Possum"], req)
+}
+
+// Param
+func BenchmarkPossum_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Possum"], req)
+}
+
+// All routes
+func BenchmarkPossum_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Possum"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkPossum_GithubLoad(b *testing.B) {
+	benchLoad(b, loadPossum, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkPossum_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Possum"], req)
+}
+
+// One Param
+func BenchmarkPossum_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Possum"], req)
+}
+
+// Two Params
+func BenchmarkPossum_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Possum"], req)
+}
+
+// All Routes
+func BenchmarkPossum_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Possum"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkPossum_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadPossum, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkPossum_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Possum"], req)
+}
+
+// One Param
+func BenchmarkPossum_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Possum"], req)
+}
+
+// Two Params
+func BenchmarkPossum_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Possum"], req)
+}
+
+// All Routes
+func BenchmarkPossum_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Possum"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkPossum_ParseLoad(b *testing.B) {
+	benchLoad(b, loadPossum, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkPossum_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Possum"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkPossum_StaticLoad(b *testing.B) {
+	benchLoad(b, loadPossum, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkPossum_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Possum"], req)
+}
+
+// Double slash
+func BenchmarkPossum_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Possum"], req)
+}
+
+// Dot segment
+func BenchmarkPossum_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Possum"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkPossum_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadPossum, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkPossum_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadPossum, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkPossum_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadPossum, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkPossum_GithubReload(b *testing.B) {
+	benchReload(b, loadPossum, githubAPI)
+}
@@ -0,0 +1,59 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !nor2router
+// +build !nor2router
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/vanng822/r2router"
+)
+
+var _ = registerRouter(benchRouter{name: "R2router", pkg: "github.com/vanng822/r2router", load: loadR2router})
+
+// R2router
+func r2routerHandler(w http.ResponseWriter, req *http.Request, _ r2router.Params) {}
+
+func r2routerHandleWrite(w http.ResponseWriter, req *http.Request, params r2router.Params) {
+	io.WriteString(w, params.Get("name"))
+}
+
+func r2routerHandleTest(w http.ResponseWriter, req *http.Request, _ r2router.Params) {
+	io.WriteString(w, req.RequestURI)
+}
+
+func r2routerHandleParams(names []string) r2router.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, params r2router.Params) {
+		for _, name := range names {
+			io.WriteString(w, params.Get(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadR2router(routes []route) http.Handler {
+	var h r2router.HandlerFunc = r2routerHandler
+	if loadTestHandler {
+		h = r2routerHandleTest
+	}
+
+	router := r2router.NewRouter()
+	for _, r := range routes {
+		if loadParamsHandler {
+			h = r2routerHandleParams(paramNames(r.path))
+		}
+		router.AddHandler(r.method, r.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadR2routerSingle(method, path string, handler r2router.HandlerFunc) http.Handler {
+	router := r2router.NewRouter()
+	router.AddHandler(method, path, handler)
+	return router
+}
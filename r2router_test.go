@@ -0,0 +1,242 @@
+//go:build !nor2router
+// +build !nor2router
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkR2router_Param(b *testing.B) {
+	router := loadR2routerSingle("GET", "/user/:name", r2routerHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkR2router_Param5(b *testing.B) {
+	router := loadR2routerSingle("GET", fiveColon, r2routerHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkR2router_Param20(b *testing.B) {
+	router := loadR2routerSingle("GET", twentyColon, r2routerHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkR2router_ParamWrite(b *testing.B) {
+	router := loadR2routerSingle("GET", "/user/:name", r2routerHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkR2router_Param5Write(b *testing.B) {
+	router := loadR2routerSingle("GET", fiveColon, r2routerHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkR2router_Param20Write(b *testing.B) {
+	router := loadR2routerSingle("GET", twentyColon, r2routerHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkR2router_ParamEncodedWrite(b *testing.B) {
+	router := loadR2routerSingle("GET", "/user/:name", r2routerHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkR2router_ParamSlashWrite(b *testing.B) {
+	router := loadR2routerSingle("GET", "/user/:name", r2routerHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkR2router_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["R2router"], req)
+}
+
+// Param
+func BenchmarkR2router_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["R2router"], req)
+}
+
+// Param and write all params
+func BenchmarkR2router_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadR2router, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkR2router_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["R2router"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkR2router_GithubLoad(b *testing.B) {
+	benchLoad(b, loadR2router, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkR2router_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["R2router"], req)
+}
+
+// One Param
+func BenchmarkR2router_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["R2router"], req)
+}
+
+// Two Params
+func BenchmarkR2router_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["R2router"], req)
+}
+
+// One Param and write
+func BenchmarkR2router_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadR2router, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkR2router_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadR2router, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkR2router_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["R2router"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkR2router_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadR2router, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkR2router_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["R2router"], req)
+}
+
+// One Param
+func BenchmarkR2router_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["R2router"], req)
+}
+
+// Two Params
+func BenchmarkR2router_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["R2router"], req)
+}
+
+// All Routes
+func BenchmarkR2router_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["R2router"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkR2router_ParseLoad(b *testing.B) {
+	benchLoad(b, loadR2router, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkR2router_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["R2router"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkR2router_StaticLoad(b *testing.B) {
+	benchLoad(b, loadR2router, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkR2router_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["R2router"], req)
+}
+
+// Double slash
+func BenchmarkR2router_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["R2router"], req)
+}
+
+// Dot segment
+func BenchmarkR2router_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["R2router"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkR2router_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadR2router, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkR2router_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadR2router, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkR2router_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadR2router, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkR2router_GithubReload(b *testing.B) {
+	benchReload(b, loadR2router, githubAPI)
+}
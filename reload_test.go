@@ -0,0 +1,204 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// swapHandler serves requests with the router last stored in it, so that a
+// router can be replaced by a newly loaded one while serving requests.
+type swapHandler struct {
+	router atomic.Value
+}
+
+func (h *swapHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.router.Load().(http.Handler).ServeHTTP(w, r)
+}
+
+func (h *swapHandler) swap(router http.Handler) {
+	h.router.Store(router)
+}
+
+// concurrently calls f in another goroutine again and again until the
+// returned function is called, which waits for f to return.
+func concurrently(f func()) (stop func()) {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-quit:
+				return
+			default:
+				f()
+			}
+		}
+	}()
+	return func() {
+		close(quit)
+		<-done
+	}
+}
+
+// serveRoute requests the route from the router and checks that the test
+// handler of the route was reached.
+func serveRoute(t *testing.T, name string, router http.Handler, route route) bool {
+	req, _ := http.NewRequest(route.method, route.path, nil)
+	req.RequestURI = route.path
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != 200 || w.Body.String() != route.path {
+		t.Errorf(
+			"%s: %d - %s; expected %s %s\n",
+			name, w.Code, w.Body.String(), route.method, route.path,
+		)
+		return false
+	}
+	return true
+}
+
+// TestReload swaps newly loaded routers in while requests are served and
+// checks that every request reaches its route.
+func TestReload(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+	loadTestHandler = true
+
+	for _, router := range routers {
+		h := new(swapHandler)
+		h.swap(router.load(githubAPI))
+
+		var failed int32
+		stop := concurrently(func() {
+			for _, route := range githubAPI {
+				if atomic.LoadInt32(&failed) != 0 {
+					// report only the first failure
+					time.Sleep(time.Millisecond)
+					return
+				}
+				if !serveRoute(t, router.name, h, route) {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		})
+		for i := 0; i < 10; i++ {
+			h.swap(router.load(githubAPI))
+		}
+		stop()
+	}
+
+	loadTestHandler = false
+}
+
+// TestLiveRegister adds the routes one by one while requests for the routes
+// added so far are served and checks that every request reaches its route.
+// Only the routers with loadLive take routes while they are serving requests,
+// all others are reloaded with all routes and swapped, see swapHandler.
+func TestLiveRegister(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+	loadTestHandler = true
+
+	for _, router := range routers {
+		if router.loadLive == nil {
+			continue
+		}
+		h, add := router.loadLive()
+
+		var added, failed int32
+		stop := concurrently(func() {
+			n := int(atomic.LoadInt32(&added))
+			for _, route := range githubAPI[:n] {
+				if atomic.LoadInt32(&failed) != 0 {
+					time.Sleep(time.Millisecond)
+					return
+				}
+				if !serveRoute(t, router.name, h, route) {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		})
+		for i, route := range githubAPI {
+			add(route)
+			atomic.StoreInt32(&added, int32(i+1))
+		}
+		stop()
+	}
+
+	loadTestHandler = false
+}
+
+// serveAll returns a function requesting all routes from the router, like
+// benchRoutes does.
+func serveAll(router http.Handler, routes []route) func() {
+	w := newResponseWriter()
+	reset := resetWriter()
+	r, _ := http.NewRequest("GET", "/", nil)
+	u := r.URL
+	rq := u.RawQuery
+
+	return func() {
+		for _, route := range routes {
+			r.Method = route.method
+			r.RequestURI = route.path
+			u.Path = route.path
+			u.RawQuery = rq
+			router.ServeHTTP(w, r)
+			if reset {
+				w.reset()
+			}
+		}
+	}
+}
+
+// benchReload benchmarks loading the routes into a new router and swapping it
+// in, while another goroutine requests all routes from the current router.
+// The allocations include those of the requests.
+func benchReload(b *testing.B, load func(routes []route) http.Handler, routes []route) {
+	skipShard(b)
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+
+	h := new(swapHandler)
+	h.swap(load(routes))
+	stop := concurrently(serveAll(h, routes))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.swap(load(routes))
+	}
+
+	b.StopTimer()
+	stop()
+}
+
+// benchRegister benchmarks adding the routes one by one to a new router,
+// while another goroutine requests all routes from it. The allocations
+// include those of the requests.
+func benchRegister(b *testing.B, load func() (http.Handler, func(route route)), routes []route) {
+	skipShard(b)
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+
+	h := new(swapHandler)
+	router, _ := load()
+	h.swap(router)
+	stop := concurrently(serveAll(h, routes))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		router, add := load()
+		h.swap(router)
+		for _, route := range routes {
+			add(route)
+		}
+	}
+
+	b.StopTimer()
+	stop()
+}
@@ -0,0 +1,60 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !norivet
+// +build !norivet
+
+package main
+
+import (
+	"net/http"
+
+	"github.com/typepress/rivet"
+)
+
+var _ = registerRouter(benchRouter{name: "Rivet", pkg: "github.com/typepress/rivet", load: loadRivet})
+
+// Rivet
+func rivetHandler() {}
+
+func rivetHandlerWrite(c *rivet.Context) {
+	c.WriteString(c.Get("name"))
+}
+
+func rivetHandlerTest(c *rivet.Context) {
+	c.WriteString(c.Req.RequestURI)
+}
+
+func rivetHandlerParams(names []string) func(*rivet.Context) {
+	return func(c *rivet.Context) {
+		for _, name := range names {
+			c.WriteString(c.Get(name))
+			c.WriteString("\n")
+		}
+	}
+}
+
+func loadRivet(routes []route) http.Handler {
+	var h interface{} = rivetHandler
+	if loadTestHandler {
+		h = rivetHandlerTest
+	}
+
+	router := rivet.New()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = rivetHandlerParams(paramNames(route.path))
+		}
+		router.Handle(route.method, route.path, h)
+	}
+	return httpMiddlewares(router)
+}
+
+func loadRivetSingle(method, path string, handler interface{}) http.Handler {
+	router := rivet.New()
+
+	router.Handle(method, path, handler)
+
+	return router
+}
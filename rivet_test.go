@@ -0,0 +1,242 @@
+//go:build !norivet
+// +build !norivet
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkRivet_Param(b *testing.B) {
+	router := loadRivetSingle("GET", "/user/:name", rivetHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkRivet_Param5(b *testing.B) {
+	router := loadRivetSingle("GET", fiveColon, rivetHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkRivet_Param20(b *testing.B) {
+	router := loadRivetSingle("GET", twentyColon, rivetHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkRivet_ParamWrite(b *testing.B) {
+	router := loadRivetSingle("GET", "/user/:name", rivetHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params and write all of them
+func BenchmarkRivet_Param5Write(b *testing.B) {
+	router := loadRivetSingle("GET", fiveColon, rivetHandlerParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params and write all of them
+func BenchmarkRivet_Param20Write(b *testing.B) {
+	router := loadRivetSingle("GET", twentyColon, rivetHandlerParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Encoded parameters, see encoded_test.go
+
+// Route with an escaped Param (space and UTF-8) and write
+func BenchmarkRivet_ParamEncodedWrite(b *testing.B) {
+	router := loadRivetSingle("GET", "/user/:name", rivetHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with a Param containing an escaped slash and write.
+// Routers matching URL.Path respond with 404 here.
+func BenchmarkRivet_ParamSlashWrite(b *testing.B) {
+	router := loadRivetSingle("GET", "/user/:name", rivetHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkRivet_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Rivet"], req)
+}
+
+// Param
+func BenchmarkRivet_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Rivet"], req)
+}
+
+// Param and write all params
+func BenchmarkRivet_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadRivet, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+
+// All routes
+func BenchmarkRivet_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Rivet"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkRivet_GithubLoad(b *testing.B) {
+	benchLoad(b, loadRivet, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkRivet_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Rivet"], req)
+}
+
+// One Param
+func BenchmarkRivet_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Rivet"], req)
+}
+
+// Two Params
+func BenchmarkRivet_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Rivet"], req)
+}
+
+// One Param and write
+func BenchmarkRivet_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadRivet, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+
+// Two Params and write both
+func BenchmarkRivet_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadRivet, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+
+// All Routes
+func BenchmarkRivet_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Rivet"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkRivet_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadRivet, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkRivet_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Rivet"], req)
+}
+
+// One Param
+func BenchmarkRivet_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Rivet"], req)
+}
+
+// Two Params
+func BenchmarkRivet_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Rivet"], req)
+}
+
+// All Routes
+func BenchmarkRivet_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Rivet"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkRivet_ParseLoad(b *testing.B) {
+	benchLoad(b, loadRivet, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkRivet_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Rivet"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkRivet_StaticLoad(b *testing.B) {
+	benchLoad(b, loadRivet, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkRivet_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Rivet"], req)
+}
+
+// Double slash
+func BenchmarkRivet_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Rivet"], req)
+}
+
+// Dot segment
+func BenchmarkRivet_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Rivet"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkRivet_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadRivet, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkRivet_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadRivet, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkRivet_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadRivet, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkRivet_GithubReload(b *testing.B) {
+	benchReload(b, loadRivet, githubAPI)
+}
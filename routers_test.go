@@ -1,36 +1,41 @@
 package main
 
 import (
+	"flag"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 )
 
-var (
-	// load functions of all routers
-	routers = []struct {
-		name string
-		load func(routes []route) http.Handler
-	}{
-		{"Ace", loadAce},
-		{"Badger", loadBadger},
-		{"Bear", loadBear},
-		{"Denco", loadDenco},
-		{"Echo", loadEcho},
-		{"Gin", loadGin},
-		{"GoJsonRest", loadGoJsonRest},
-		{"GorillaMux", loadGorillaMux},
-		{"HttpRouter", loadHttpRouter},
-		{"HttpTreeMux", loadHttpTreeMux},
-		{"LARS", loadLARS},
-		{"Martini", loadMartini},
-		{"Possum", loadPossum},
-		{"R2router", loadR2router},
-		{"Rivet", loadRivet},
-		{"Vulcan", loadVulcan},
-		// {"Zeus", loadZeus},
+// TestMain reports the versions of the routers, see printVersions, the
+// environment, see printEnvironment, the reference router, see printReference,
+// and the routers excluded with build tags, see routerConstraints, before
+// running the tests and benchmarks of the shard, see setShard.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if err := setShard(); err != nil {
+		println(err.Error())
+		os.Exit(2)
+	}
+	if bench := flag.Lookup("test.bench"); bench.Value.String() != "" {
+		bench.Value.Set(benchWithReference(bench.Value.String()))
+	}
+	printVersions()
+	printEnvironment()
+	printReference()
+	if excluded := excludedRouters(); len(excluded) > 0 {
+		println("#Excluded Routers:", len(excluded))
+		for _, name := range excluded {
+			println("   "+name+":", "//go:build", routerConstraints[name])
+		}
+		println()
 	}
 
+	os.Exit(m.Run())
+}
+
+var (
 	// all APIs
 	apis = []struct {
 		name   string
@@ -0,0 +1,65 @@
+//go:build go1.22
+// +build go1.22
+
+// Without a go.mod the suite is built in GOPATH mode, which gets the GODEBUG
+// defaults of Go 1.20, so http.ServeMux would ignore methods and wildcards.
+//go:debug httpmuxgo121=0
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var _ = registerRouter(benchRouter{name: "HttpServeMux", pkg: "net/http", load: loadHttpServeMux})
+
+// http.ServeMux, which matches methods and wildcards since Go 1.22
+func httpServeMuxHandleWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.PathValue("name"))
+}
+
+func httpServeMuxHandleParams(names []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, name := range names {
+			io.WriteString(w, r.PathValue(name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+// httpServeMuxPattern returns the pattern for a route, e.g.
+// GET /repos/{owner}/{repo} for /repos/:owner/:repo. Paths with a trailing
+// slash only match exactly, not all paths below them.
+func httpServeMuxPattern(re *regexp.Regexp, method, path string) string {
+	path = re.ReplaceAllString(path, "{$1}")
+	if strings.HasSuffix(path, "/") {
+		path += "{$}"
+	}
+	return method + " " + path
+}
+
+func loadHttpServeMux(routes []route) http.Handler {
+	var h http.HandlerFunc = httpHandlerFunc
+	if loadTestHandler {
+		h = httpHandlerFuncTest
+	}
+
+	re := regexp.MustCompile(":([^/]*)")
+	serveMux := http.NewServeMux()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = httpServeMuxHandleParams(paramNames(route.path))
+		}
+		serveMux.HandleFunc(httpServeMuxPattern(re, route.method, route.path), h)
+	}
+	return httpMiddlewares(serveMux)
+}
+
+func loadHttpServeMuxSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc(method+" "+path, handler)
+	return serveMux
+}
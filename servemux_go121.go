@@ -0,0 +1,16 @@
+//go:build !go1.22
+// +build !go1.22
+
+package main
+
+import "net/http"
+
+// http.ServeMux can only match static routes before Go 1.22, so it only takes
+// part in the static routes, see static_test.go
+func loadHttpServeMux(routes []route) http.Handler {
+	serveMux := http.NewServeMux()
+	for _, route := range routes {
+		serveMux.HandleFunc(route.path, httpHandlerFunc)
+	}
+	return serveMux
+}
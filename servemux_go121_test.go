@@ -0,0 +1,14 @@
+//go:build !go1.22
+// +build !go1.22
+
+package main
+
+import "testing"
+
+// Static, the only routes http.ServeMux can match before Go 1.22
+func BenchmarkHttpServeMux_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["HttpServeMux"], staticRoutes)
+}
+func BenchmarkHttpServeMux_StaticLoad(b *testing.B) {
+	benchLoad(b, loadHttpServeMux, staticRoutes)
+}
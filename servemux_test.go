@@ -0,0 +1,181 @@
+//go:build go1.22
+// +build go1.22
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHttpServeMuxWildcard checks that http.ServeMux matches methods and
+// wildcards, which it does not with GODEBUG=httpmuxgo121=1, see servemux.go.
+func TestHttpServeMuxWildcard(t *testing.T) {
+	router := loadHttpServeMuxSingle("GET", "/user/{name}", httpServeMuxHandleWrite)
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "/user/gopher", nil)
+	router.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || w.Body.String() != "gopher" {
+		t.Errorf("GET /user/gopher: %d - %q; expected 200 - \"gopher\"", w.Code, w.Body.String())
+	}
+}
+
+// Micro Benchmarks
+func BenchmarkHttpServeMux_Param(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", "/user/{name}", httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_Param5(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", fiveBrace, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_Param20(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", twentyBrace, httpHandlerFunc)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_ParamWrite(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", "/user/{name}", httpServeMuxHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_Param5Write(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", fiveBrace, httpServeMuxHandleParams(fiveNames))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_Param20Write(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", twentyBrace, httpServeMuxHandleParams(twentyNames))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_ParamEncodedWrite(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", "/user/{name}", httpServeMuxHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/g%C3%B6r%20don", nil)
+	benchRequest(b, router, r)
+}
+func BenchmarkHttpServeMux_ParamSlashWrite(b *testing.B) {
+	router := loadHttpServeMuxSingle("GET", "/user/{name}", httpServeMuxHandleWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gor%2Fdon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub
+func BenchmarkHttpServeMux_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GithubParamWrite(b *testing.B) {
+	router := loadParams(loadHttpServeMux, githubAPI)
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkHttpServeMux_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["HttpServeMux"], githubAPI)
+}
+func BenchmarkHttpServeMux_GithubLoad(b *testing.B) {
+	benchLoad(b, loadHttpServeMux, githubAPI)
+}
+func BenchmarkHttpServeMux_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GithubReload(b *testing.B) {
+	benchReload(b, loadHttpServeMux, githubAPI)
+}
+
+// Google+
+func BenchmarkHttpServeMux_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_GPlusParamWrite(b *testing.B) {
+	router := loadParams(loadHttpServeMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkHttpServeMux_GPlus2ParamsWrite(b *testing.B) {
+	router := loadParams(loadHttpServeMux, gplusAPI)
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, router, req)
+}
+func BenchmarkHttpServeMux_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["HttpServeMux"], gplusAPI)
+}
+func BenchmarkHttpServeMux_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadHttpServeMux, gplusAPI)
+}
+
+// Parse
+func BenchmarkHttpServeMux_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["HttpServeMux"], req)
+}
+func BenchmarkHttpServeMux_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["HttpServeMux"], parseAPI)
+}
+func BenchmarkHttpServeMux_ParseLoad(b *testing.B) {
+	benchLoad(b, loadHttpServeMux, parseAPI)
+}
+
+// Static
+func BenchmarkHttpServeMux_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["HttpServeMux"], staticRoutes)
+}
+func BenchmarkHttpServeMux_StaticLoad(b *testing.B) {
+	benchLoad(b, loadHttpServeMux, staticRoutes)
+}
+
+// Middlewares
+func BenchmarkHttpServeMux_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpServeMux, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkHttpServeMux_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpServeMux, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+func BenchmarkHttpServeMux_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadHttpServeMux, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
@@ -4,313 +4,184 @@
 
 package main
 
-import (
-	"net/http"
-	"testing"
-)
+import "net/http"
 
 var staticRoutes = []route{
-	{"GET", "/"},
-	{"GET", "/cmd.html"},
-	{"GET", "/code.html"},
-	{"GET", "/contrib.html"},
-	{"GET", "/contribute.html"},
-	{"GET", "/debugging_with_gdb.html"},
-	{"GET", "/docs.html"},
-	{"GET", "/effective_go.html"},
-	{"GET", "/files.log"},
-	{"GET", "/gccgo_contribute.html"},
-	{"GET", "/gccgo_install.html"},
-	{"GET", "/go-logo-black.png"},
-	{"GET", "/go-logo-blue.png"},
-	{"GET", "/go-logo-white.png"},
-	{"GET", "/go1.1.html"},
-	{"GET", "/go1.2.html"},
-	{"GET", "/go1.html"},
-	{"GET", "/go1compat.html"},
-	{"GET", "/go_faq.html"},
-	{"GET", "/go_mem.html"},
-	{"GET", "/go_spec.html"},
-	{"GET", "/help.html"},
-	{"GET", "/ie.css"},
-	{"GET", "/install-source.html"},
-	{"GET", "/install.html"},
-	{"GET", "/logo-153x55.png"},
-	{"GET", "/Makefile"},
-	{"GET", "/root.html"},
-	{"GET", "/share.png"},
-	{"GET", "/sieve.gif"},
-	{"GET", "/tos.html"},
-	{"GET", "/articles/"},
-	{"GET", "/articles/go_command.html"},
-	{"GET", "/articles/index.html"},
-	{"GET", "/articles/wiki/"},
-	{"GET", "/articles/wiki/edit.html"},
-	{"GET", "/articles/wiki/final-noclosure.go"},
-	{"GET", "/articles/wiki/final-noerror.go"},
-	{"GET", "/articles/wiki/final-parsetemplate.go"},
-	{"GET", "/articles/wiki/final-template.go"},
-	{"GET", "/articles/wiki/final.go"},
-	{"GET", "/articles/wiki/get.go"},
-	{"GET", "/articles/wiki/http-sample.go"},
-	{"GET", "/articles/wiki/index.html"},
-	{"GET", "/articles/wiki/Makefile"},
-	{"GET", "/articles/wiki/notemplate.go"},
-	{"GET", "/articles/wiki/part1-noerror.go"},
-	{"GET", "/articles/wiki/part1.go"},
-	{"GET", "/articles/wiki/part2.go"},
-	{"GET", "/articles/wiki/part3-errorhandling.go"},
-	{"GET", "/articles/wiki/part3.go"},
-	{"GET", "/articles/wiki/test.bash"},
-	{"GET", "/articles/wiki/test_edit.good"},
-	{"GET", "/articles/wiki/test_Test.txt.good"},
-	{"GET", "/articles/wiki/test_view.good"},
-	{"GET", "/articles/wiki/view.html"},
-	{"GET", "/codewalk/"},
-	{"GET", "/codewalk/codewalk.css"},
-	{"GET", "/codewalk/codewalk.js"},
-	{"GET", "/codewalk/codewalk.xml"},
-	{"GET", "/codewalk/functions.xml"},
-	{"GET", "/codewalk/markov.go"},
-	{"GET", "/codewalk/markov.xml"},
-	{"GET", "/codewalk/pig.go"},
-	{"GET", "/codewalk/popout.png"},
-	{"GET", "/codewalk/run"},
-	{"GET", "/codewalk/sharemem.xml"},
-	{"GET", "/codewalk/urlpoll.go"},
-	{"GET", "/devel/"},
-	{"GET", "/devel/release.html"},
-	{"GET", "/devel/weekly.html"},
-	{"GET", "/gopher/"},
-	{"GET", "/gopher/appenginegopher.jpg"},
-	{"GET", "/gopher/appenginegophercolor.jpg"},
-	{"GET", "/gopher/appenginelogo.gif"},
-	{"GET", "/gopher/bumper.png"},
-	{"GET", "/gopher/bumper192x108.png"},
-	{"GET", "/gopher/bumper320x180.png"},
-	{"GET", "/gopher/bumper480x270.png"},
-	{"GET", "/gopher/bumper640x360.png"},
-	{"GET", "/gopher/doc.png"},
-	{"GET", "/gopher/frontpage.png"},
-	{"GET", "/gopher/gopherbw.png"},
-	{"GET", "/gopher/gophercolor.png"},
-	{"GET", "/gopher/gophercolor16x16.png"},
-	{"GET", "/gopher/help.png"},
-	{"GET", "/gopher/pkg.png"},
-	{"GET", "/gopher/project.png"},
-	{"GET", "/gopher/ref.png"},
-	{"GET", "/gopher/run.png"},
-	{"GET", "/gopher/talks.png"},
-	{"GET", "/gopher/pencil/"},
-	{"GET", "/gopher/pencil/gopherhat.jpg"},
-	{"GET", "/gopher/pencil/gopherhelmet.jpg"},
-	{"GET", "/gopher/pencil/gophermega.jpg"},
-	{"GET", "/gopher/pencil/gopherrunning.jpg"},
-	{"GET", "/gopher/pencil/gopherswim.jpg"},
-	{"GET", "/gopher/pencil/gopherswrench.jpg"},
-	{"GET", "/play/"},
-	{"GET", "/play/fib.go"},
-	{"GET", "/play/hello.go"},
-	{"GET", "/play/life.go"},
-	{"GET", "/play/peano.go"},
-	{"GET", "/play/pi.go"},
-	{"GET", "/play/sieve.go"},
-	{"GET", "/play/solitaire.go"},
-	{"GET", "/play/tree.go"},
-	{"GET", "/progs/"},
-	{"GET", "/progs/cgo1.go"},
-	{"GET", "/progs/cgo2.go"},
-	{"GET", "/progs/cgo3.go"},
-	{"GET", "/progs/cgo4.go"},
-	{"GET", "/progs/defer.go"},
-	{"GET", "/progs/defer.out"},
-	{"GET", "/progs/defer2.go"},
-	{"GET", "/progs/defer2.out"},
-	{"GET", "/progs/eff_bytesize.go"},
-	{"GET", "/progs/eff_bytesize.out"},
-	{"GET", "/progs/eff_qr.go"},
-	{"GET", "/progs/eff_sequence.go"},
-	{"GET", "/progs/eff_sequence.out"},
-	{"GET", "/progs/eff_unused1.go"},
-	{"GET", "/progs/eff_unused2.go"},
-	{"GET", "/progs/error.go"},
-	{"GET", "/progs/error2.go"},
-	{"GET", "/progs/error3.go"},
-	{"GET", "/progs/error4.go"},
-	{"GET", "/progs/go1.go"},
-	{"GET", "/progs/gobs1.go"},
-	{"GET", "/progs/gobs2.go"},
-	{"GET", "/progs/image_draw.go"},
-	{"GET", "/progs/image_package1.go"},
-	{"GET", "/progs/image_package1.out"},
-	{"GET", "/progs/image_package2.go"},
-	{"GET", "/progs/image_package2.out"},
-	{"GET", "/progs/image_package3.go"},
-	{"GET", "/progs/image_package3.out"},
-	{"GET", "/progs/image_package4.go"},
-	{"GET", "/progs/image_package4.out"},
-	{"GET", "/progs/image_package5.go"},
-	{"GET", "/progs/image_package5.out"},
-	{"GET", "/progs/image_package6.go"},
-	{"GET", "/progs/image_package6.out"},
-	{"GET", "/progs/interface.go"},
-	{"GET", "/progs/interface2.go"},
-	{"GET", "/progs/interface2.out"},
-	{"GET", "/progs/json1.go"},
-	{"GET", "/progs/json2.go"},
-	{"GET", "/progs/json2.out"},
-	{"GET", "/progs/json3.go"},
-	{"GET", "/progs/json4.go"},
-	{"GET", "/progs/json5.go"},
-	{"GET", "/progs/run"},
-	{"GET", "/progs/slices.go"},
-	{"GET", "/progs/timeout1.go"},
-	{"GET", "/progs/timeout2.go"},
-	{"GET", "/progs/update.bash"},
+	{method: "GET", path: "/"},
+	{method: "GET", path: "/cmd.html"},
+	{method: "GET", path: "/code.html"},
+	{method: "GET", path: "/contrib.html"},
+	{method: "GET", path: "/contribute.html"},
+	{method: "GET", path: "/debugging_with_gdb.html"},
+	{method: "GET", path: "/docs.html"},
+	{method: "GET", path: "/effective_go.html"},
+	{method: "GET", path: "/files.log"},
+	{method: "GET", path: "/gccgo_contribute.html"},
+	{method: "GET", path: "/gccgo_install.html"},
+	{method: "GET", path: "/go-logo-black.png"},
+	{method: "GET", path: "/go-logo-blue.png"},
+	{method: "GET", path: "/go-logo-white.png"},
+	{method: "GET", path: "/go1.1.html"},
+	{method: "GET", path: "/go1.2.html"},
+	{method: "GET", path: "/go1.html"},
+	{method: "GET", path: "/go1compat.html"},
+	{method: "GET", path: "/go_faq.html"},
+	{method: "GET", path: "/go_mem.html"},
+	{method: "GET", path: "/go_spec.html"},
+	{method: "GET", path: "/help.html"},
+	{method: "GET", path: "/ie.css"},
+	{method: "GET", path: "/install-source.html"},
+	{method: "GET", path: "/install.html"},
+	{method: "GET", path: "/logo-153x55.png"},
+	{method: "GET", path: "/Makefile"},
+	{method: "GET", path: "/root.html"},
+	{method: "GET", path: "/share.png"},
+	{method: "GET", path: "/sieve.gif"},
+	{method: "GET", path: "/tos.html"},
+	{method: "GET", path: "/articles/"},
+	{method: "GET", path: "/articles/go_command.html"},
+	{method: "GET", path: "/articles/index.html"},
+	{method: "GET", path: "/articles/wiki/"},
+	{method: "GET", path: "/articles/wiki/edit.html"},
+	{method: "GET", path: "/articles/wiki/final-noclosure.go"},
+	{method: "GET", path: "/articles/wiki/final-noerror.go"},
+	{method: "GET", path: "/articles/wiki/final-parsetemplate.go"},
+	{method: "GET", path: "/articles/wiki/final-template.go"},
+	{method: "GET", path: "/articles/wiki/final.go"},
+	{method: "GET", path: "/articles/wiki/get.go"},
+	{method: "GET", path: "/articles/wiki/http-sample.go"},
+	{method: "GET", path: "/articles/wiki/index.html"},
+	{method: "GET", path: "/articles/wiki/Makefile"},
+	{method: "GET", path: "/articles/wiki/notemplate.go"},
+	{method: "GET", path: "/articles/wiki/part1-noerror.go"},
+	{method: "GET", path: "/articles/wiki/part1.go"},
+	{method: "GET", path: "/articles/wiki/part2.go"},
+	{method: "GET", path: "/articles/wiki/part3-errorhandling.go"},
+	{method: "GET", path: "/articles/wiki/part3.go"},
+	{method: "GET", path: "/articles/wiki/test.bash"},
+	{method: "GET", path: "/articles/wiki/test_edit.good"},
+	{method: "GET", path: "/articles/wiki/test_Test.txt.good"},
+	{method: "GET", path: "/articles/wiki/test_view.good"},
+	{method: "GET", path: "/articles/wiki/view.html"},
+	{method: "GET", path: "/codewalk/"},
+	{method: "GET", path: "/codewalk/codewalk.css"},
+	{method: "GET", path: "/codewalk/codewalk.js"},
+	{method: "GET", path: "/codewalk/codewalk.xml"},
+	{method: "GET", path: "/codewalk/functions.xml"},
+	{method: "GET", path: "/codewalk/markov.go"},
+	{method: "GET", path: "/codewalk/markov.xml"},
+	{method: "GET", path: "/codewalk/pig.go"},
+	{method: "GET", path: "/codewalk/popout.png"},
+	{method: "GET", path: "/codewalk/run"},
+	{method: "GET", path: "/codewalk/sharemem.xml"},
+	{method: "GET", path: "/codewalk/urlpoll.go"},
+	{method: "GET", path: "/devel/"},
+	{method: "GET", path: "/devel/release.html"},
+	{method: "GET", path: "/devel/weekly.html"},
+	{method: "GET", path: "/gopher/"},
+	{method: "GET", path: "/gopher/appenginegopher.jpg"},
+	{method: "GET", path: "/gopher/appenginegophercolor.jpg"},
+	{method: "GET", path: "/gopher/appenginelogo.gif"},
+	{method: "GET", path: "/gopher/bumper.png"},
+	{method: "GET", path: "/gopher/bumper192x108.png"},
+	{method: "GET", path: "/gopher/bumper320x180.png"},
+	{method: "GET", path: "/gopher/bumper480x270.png"},
+	{method: "GET", path: "/gopher/bumper640x360.png"},
+	{method: "GET", path: "/gopher/doc.png"},
+	{method: "GET", path: "/gopher/frontpage.png"},
+	{method: "GET", path: "/gopher/gopherbw.png"},
+	{method: "GET", path: "/gopher/gophercolor.png"},
+	{method: "GET", path: "/gopher/gophercolor16x16.png"},
+	{method: "GET", path: "/gopher/help.png"},
+	{method: "GET", path: "/gopher/pkg.png"},
+	{method: "GET", path: "/gopher/project.png"},
+	{method: "GET", path: "/gopher/ref.png"},
+	{method: "GET", path: "/gopher/run.png"},
+	{method: "GET", path: "/gopher/talks.png"},
+	{method: "GET", path: "/gopher/pencil/"},
+	{method: "GET", path: "/gopher/pencil/gopherhat.jpg"},
+	{method: "GET", path: "/gopher/pencil/gopherhelmet.jpg"},
+	{method: "GET", path: "/gopher/pencil/gophermega.jpg"},
+	{method: "GET", path: "/gopher/pencil/gopherrunning.jpg"},
+	{method: "GET", path: "/gopher/pencil/gopherswim.jpg"},
+	{method: "GET", path: "/gopher/pencil/gopherswrench.jpg"},
+	{method: "GET", path: "/play/"},
+	{method: "GET", path: "/play/fib.go"},
+	{method: "GET", path: "/play/hello.go"},
+	{method: "GET", path: "/play/life.go"},
+	{method: "GET", path: "/play/peano.go"},
+	{method: "GET", path: "/play/pi.go"},
+	{method: "GET", path: "/play/sieve.go"},
+	{method: "GET", path: "/play/solitaire.go"},
+	{method: "GET", path: "/play/tree.go"},
+	{method: "GET", path: "/progs/"},
+	{method: "GET", path: "/progs/cgo1.go"},
+	{method: "GET", path: "/progs/cgo2.go"},
+	{method: "GET", path: "/progs/cgo3.go"},
+	{method: "GET", path: "/progs/cgo4.go"},
+	{method: "GET", path: "/progs/defer.go"},
+	{method: "GET", path: "/progs/defer.out"},
+	{method: "GET", path: "/progs/defer2.go"},
+	{method: "GET", path: "/progs/defer2.out"},
+	{method: "GET", path: "/progs/eff_bytesize.go"},
+	{method: "GET", path: "/progs/eff_bytesize.out"},
+	{method: "GET", path: "/progs/eff_qr.go"},
+	{method: "GET", path: "/progs/eff_sequence.go"},
+	{method: "GET", path: "/progs/eff_sequence.out"},
+	{method: "GET", path: "/progs/eff_unused1.go"},
+	{method: "GET", path: "/progs/eff_unused2.go"},
+	{method: "GET", path: "/progs/error.go"},
+	{method: "GET", path: "/progs/error2.go"},
+	{method: "GET", path: "/progs/error3.go"},
+	{method: "GET", path: "/progs/error4.go"},
+	{method: "GET", path: "/progs/go1.go"},
+	{method: "GET", path: "/progs/gobs1.go"},
+	{method: "GET", path: "/progs/gobs2.go"},
+	{method: "GET", path: "/progs/image_draw.go"},
+	{method: "GET", path: "/progs/image_package1.go"},
+	{method: "GET", path: "/progs/image_package1.out"},
+	{method: "GET", path: "/progs/image_package2.go"},
+	{method: "GET", path: "/progs/image_package2.out"},
+	{method: "GET", path: "/progs/image_package3.go"},
+	{method: "GET", path: "/progs/image_package3.out"},
+	{method: "GET", path: "/progs/image_package4.go"},
+	{method: "GET", path: "/progs/image_package4.out"},
+	{method: "GET", path: "/progs/image_package5.go"},
+	{method: "GET", path: "/progs/image_package5.out"},
+	{method: "GET", path: "/progs/image_package6.go"},
+	{method: "GET", path: "/progs/image_package6.out"},
+	{method: "GET", path: "/progs/interface.go"},
+	{method: "GET", path: "/progs/interface2.go"},
+	{method: "GET", path: "/progs/interface2.out"},
+	{method: "GET", path: "/progs/json1.go"},
+	{method: "GET", path: "/progs/json2.go"},
+	{method: "GET", path: "/progs/json2.out"},
+	{method: "GET", path: "/progs/json3.go"},
+	{method: "GET", path: "/progs/json4.go"},
+	{method: "GET", path: "/progs/json5.go"},
+	{method: "GET", path: "/progs/run"},
+	{method: "GET", path: "/progs/slices.go"},
+	{method: "GET", path: "/progs/timeout1.go"},
+	{method: "GET", path: "/progs/timeout2.go"},
+	{method: "GET", path: "/progs/update.bash"},
 }
 
-var (
-	staticHttpServeMux http.Handler
-
-	staticAce         http.Handler
-	staticBadger      http.Handler
-	staticBear        http.Handler
-	staticDenco       http.Handler
-	staticEcho        http.Handler
-	staticGin         http.Handler
-	staticGoJsonRest  http.Handler
-	staticGorillaMux  http.Handler
-	staticHttpRouter  http.Handler
-	staticHttpTreeMux http.Handler
-	staticLARS        http.Handler
-	staticMartini     http.Handler
-	staticPossum      http.Handler
-	staticR2router    http.Handler
-	staticRivet       http.Handler
-	staticVulcan      http.Handler
-	// staticZeus        http.Handler
-)
+// the static routes loaded into every router, by name
+var staticRouters = make(map[string]http.Handler)
 
 func init() {
 	println("#Static Routes:", len(staticRoutes))
 
-	calcMem("HttpServeMux", func() {
-		serveMux := http.NewServeMux()
-		for _, route := range staticRoutes {
-			serveMux.HandleFunc(route.path, httpHandlerFunc)
-		}
-		staticHttpServeMux = serveMux
-	})
-
-	calcMem("Ace", func() {
-		staticAce = loadAce(staticRoutes)
-	})
-	calcMem("Badger", func() {
-		staticBadger = loadBadger(staticRoutes)
-	})
-	calcMem("Bear", func() {
-		staticBear = loadBear(staticRoutes)
-	})
-	calcMem("Denco", func() {
-		staticDenco = loadDenco(staticRoutes)
-	})
-	calcMem("Echo", func() {
-		staticEcho = loadEcho(staticRoutes)
-	})
-	calcMem("Gin", func() {
-		staticGin = loadGin(staticRoutes)
-	})
-	calcMem("GoJsonRest", func() {
-		staticGoJsonRest = loadGoJsonRest(staticRoutes)
-	})
-	calcMem("GorillaMux", func() {
-		staticGorillaMux = loadGorillaMux(staticRoutes)
-	})
-	calcMem("HttpRouter", func() {
-		staticHttpRouter = loadHttpRouter(staticRoutes)
-	})
-	calcMem("HttpTreeMux", func() {
-		staticHttpTreeMux = loadHttpTreeMux(staticRoutes)
-	})
-	calcMem("LARS", func() {
-		staticLARS = loadLARS(staticRoutes)
-	})
-	calcMem("Martini", func() {
-		staticMartini = loadMartini(staticRoutes)
-	})
-	calcMem("Possum", func() {
-		staticPossum = loadPossum(staticRoutes)
-	})
-	calcMem("R2router", func() {
-		staticR2router = loadR2router(staticRoutes)
-	})
-	calcMem("Rivet", func() {
-		staticRivet = loadRivet(staticRoutes)
-	})
-	calcMem("Vulcan", func() {
-		staticVulcan = loadVulcan(staticRoutes)
-	})
-	// calcMem("Zeus", func() {
-	// 	staticZeus = loadZeus(staticRoutes)
-	// })
+	calcMem("BaselineMap", func() {
+		staticRouters["BaselineMap"] = loadBaselineMap(staticRoutes)
+	})
+	if findRouter("HttpServeMux") == nil {
+		// before Go 1.22, see servemux_go121.go
+		calcMem("HttpServeMux", func() {
+			staticRouters["HttpServeMux"] = loadHttpServeMux(staticRoutes)
+		})
+	}
+	loadRouters(staticRouters, staticRoutes)
 
 	println()
 }
-
-// All routes
-
-func BenchmarkAce_StaticAll(b *testing.B) {
-	benchRoutes(b, staticAce, staticRoutes)
-}
-func BenchmarkBadger_StaticAll(b *testing.B) {
-	benchRoutes(b, staticBadger, staticRoutes)
-}
-func BenchmarkHttpServeMux_StaticAll(b *testing.B) {
-	benchRoutes(b, staticHttpServeMux, staticRoutes)
-}
-func BenchmarkBear_StaticAll(b *testing.B) {
-	benchRoutes(b, staticBear, staticRoutes)
-}
-func BenchmarkDenco_StaticAll(b *testing.B) {
-	benchRoutes(b, staticDenco, staticRoutes)
-}
-func BenchmarkEcho_StaticAll(b *testing.B) {
-	benchRoutes(b, staticEcho, staticRoutes)
-}
-func BenchmarkGin_StaticAll(b *testing.B) {
-	benchRoutes(b, staticGin, staticRoutes)
-}
-func BenchmarkGoJsonRest_StaticAll(b *testing.B) {
-	benchRoutes(b, staticGoJsonRest, staticRoutes)
-}
-func BenchmarkGorillaMux_StaticAll(b *testing.B) {
-	benchRoutes(b, staticGorillaMux, staticRoutes)
-}
-func BenchmarkHttpRouter_StaticAll(b *testing.B) {
-	benchRoutes(b, staticHttpRouter, staticRoutes)
-}
-func BenchmarkHttpTreeMux_StaticAll(b *testing.B) {
-	benchRoutes(b, staticHttpRouter, staticRoutes)
-}
-func BenchmarkLARS_StaticAll(b *testing.B) {
-	benchRoutes(b, staticLARS, staticRoutes)
-}
-func BenchmarkMartini_StaticAll(b *testing.B) {
-	benchRoutes(b, staticMartini, staticRoutes)
-}
-func BenchmarkPossum_StaticAll(b *testing.B) {
-	benchRoutes(b, staticPossum, staticRoutes)
-}
-func BenchmarkR2router_StaticAll(b *testing.B) {
-	benchRoutes(b, staticR2router, staticRoutes)
-}
-func BenchmarkRivet_StaticAll(b *testing.B) {
-	benchRoutes(b, staticRivet, staticRoutes)
-}
-func BenchmarkVulcan_StaticAll(b *testing.B) {
-	benchRoutes(b, staticVulcan, staticRoutes)
-}
-
-// func BenchmarkZeus_StaticAll(b *testing.B) {
-// 	benchRoutes(b, staticZeus, staticRoutes)
-// }
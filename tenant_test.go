@@ -0,0 +1,169 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Multi-tenant API: the GitHub API on the main domain and for every tenant on
+// its own subdomain. The same paths lead to different routes depending on the
+// host.
+var tenantAPI = func() []route {
+	routes := make([]route, 0, 2*len(githubAPI))
+	for _, host := range []string{"api.example.com", ":tenant.api.example.com"} {
+		for _, r := range githubAPI {
+			r.host = host
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}()
+
+// the tenant API loaded into every router which can match hosts, see
+// benchRouter.hosts, by name. All other routers do not support host
+// matching, Echo only does from v4 on.
+var tenantRouters = make(map[string]http.Handler)
+
+func init() {
+	println("#Tenant Routes:", len(tenantAPI))
+
+	for _, router := range routers {
+		if router.hosts {
+			calcMem(router.name, func() {
+				tenantRouters[router.name] = router.load(tenantAPI)
+			})
+		}
+	}
+
+	println()
+
+	features = append(features, feature{
+		name: "Host matching",
+		check: func(router string, _ func(routes []route) http.Handler) string {
+			r := findRouter(router)
+			if r == nil || !r.hosts {
+				return "unsupported"
+			}
+
+			loadTestHandler = true
+			h := r.load(tenantAPI)
+			loadTestHandler = false
+
+			req := newRawRequest("GET", "/users/gordon")
+			req.Host = "acme.api.example.com"
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Body.String() != ":tenant.api.example.com /users/gordon" {
+				return featureResult(h, req)
+			}
+			return "yes"
+		},
+	})
+}
+
+// tenantHost returns a concrete host for a host pattern.
+func tenantHost(pattern string) string {
+	return strings.Replace(pattern, ":tenant", "acme", 1)
+}
+
+func TestHostRouters(t *testing.T) {
+	loadTestHandler = true
+
+	for _, router := range routers {
+		if !router.hosts {
+			continue
+		}
+		r := router.load(tenantAPI)
+
+		for _, route := range tenantAPI {
+			req := newRawRequest(route.method, route.path)
+			req.Host = tenantHost(route.host)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			expected := route.host + " " + route.path
+			if w.Code != 200 || w.Body.String() != expected {
+				t.Errorf(
+					"%s: %d - %s; expected %s for %s %s%s\n",
+					router.name, w.Code, w.Body.String(), expected, route.method, req.Host, route.path,
+				)
+			}
+		}
+
+		req := newRawRequest("GET", "/users/gordon")
+		req.Host = "api.example.org"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != 404 {
+			t.Errorf("%s: %d for unknown host %s; expected 404", router.name, w.Code, req.Host)
+		}
+	}
+
+	loadTestHandler = false
+}
+
+func TestHostRouterParams(t *testing.T) {
+	loadParamsHandler = true
+
+	for _, router := range routers {
+		if _, ok := routersWithoutParams[router.name]; ok || !router.hosts {
+			continue
+		}
+		r := router.load(tenantAPI)
+
+		for _, route := range tenantAPI {
+			path, expected := paramRequest(route.path)
+			if route.host != "api.example.com" {
+				expected = "acme\n" + expected
+			}
+
+			req := newRawRequest(route.method, path)
+			req.Host = tenantHost(route.host)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != expected {
+				t.Errorf(
+					"%s: %d - %q; expected %q for %s %s%s\n",
+					router.name, w.Code, w.Body.String(), expected, route.method, req.Host, path,
+				)
+			}
+		}
+	}
+
+	loadParamsHandler = false
+}
+
+// benchHostRoutes requests all routes like benchRoutes, with the host of the
+// route.
+func benchHostRoutes(b *testing.B, router http.Handler, routes []route) {
+	skipShard(b)
+	w := newResponseWriter()
+	reset := resetWriter()
+	r, _ := http.NewRequest("GET", "/", nil)
+	u := r.URL
+	rq := u.RawQuery
+
+	hosts := make([]string, len(routes))
+	for i, route := range routes {
+		hosts[i] = tenantHost(route.host)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, route := range routes {
+			r.Method = route.method
+			r.Host = hosts[j]
+			r.RequestURI = route.path
+			u.Path = route.path
+			u.RawQuery = rq
+			router.ServeHTTP(w, r)
+			if reset {
+				w.reset()
+			}
+		}
+	}
+}
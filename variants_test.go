@@ -0,0 +1,157 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// Variants of a request path which clean to the same path. Routers either
+// match them, redirect to the clean path or respond with 404.
+var pathVariants = []struct {
+	name    string
+	variant func(path string) string
+}{
+	{"Trailing slash", func(path string) string {
+		return path + "/"
+	}},
+	{"Double slash", func(path string) string {
+		return "/" + path
+	}},
+	{"Dot segment", func(path string) string {
+		// insert after the first segment, e.g. /repos/./julienschmidt/httprouter
+		if i := strings.IndexByte(path[1:], '/'); i >= 0 {
+			return path[:i+1] + "/." + path[i+1:]
+		}
+		return "/." + path
+	}},
+	{"Parent segment", func(path string) string {
+		return "/x/.." + path
+	}},
+}
+
+// GitHub examples of the variants, the feature matrix shows the response of
+// the routers
+var pathVariantExamples = []struct {
+	variant string
+	path    string
+}{
+	{"Trailing slash", "/users/gordon/"},
+	{"Double slash", "//gists"},
+	{"Dot segment", "/repos/./julienschmidt/httprouter"},
+	{"Parent segment", "/x/../gists"},
+}
+
+func init() {
+	for _, example := range pathVariantExamples {
+		example := example
+		features = append(features, feature{
+			name: example.path,
+			check: func(router string, load func(routes []route) http.Handler) string {
+				if _, ok := routersWithoutParams[router]; ok {
+					return "-"
+				}
+				h := loadParams(load, githubAPI)
+				return featureResult(h, newRawRequest("GET", example.path))
+			},
+		})
+	}
+}
+
+// TestPathVariants sends the variants of a concrete path of every route and
+// checks that a router which matches the variant hands out the parameters of
+// the route and that a router which redirects points to the clean path.
+func TestPathVariants(t *testing.T) {
+	for _, router := range routers {
+		if _, ok := routersWithoutParams[router.name]; ok {
+			continue
+		}
+
+		for _, api := range apis {
+			r := loadParams(router.load, api.routes)
+
+			for _, route := range api.routes {
+				if route.path == "/" {
+					continue
+				}
+				path, expected := paramRequest(route.path)
+
+				for _, v := range pathVariants {
+					req := newRawRequest(route.method, v.variant(path))
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					switch w.Code {
+					case 200:
+						if w.Body.String() != expected {
+							t.Errorf(
+								"%s in API %s: %s %s matched with parameters %q; expected %q for %s\n",
+								router.name, api.name, req.Method, req.RequestURI, w.Body.String(), expected, route.path,
+							)
+						}
+					case 301, 302, 307, 308:
+						location, err := url.Parse(w.Header().Get("Location"))
+						if err != nil || location.Path != path {
+							t.Errorf(
+								"%s in API %s: %s %s redirected to %q; expected %s\n",
+								router.name, api.name, req.Method, req.RequestURI, w.Header().Get("Location"), path,
+							)
+						}
+					case 404, 405:
+					default:
+						t.Errorf(
+							"%s in API %s: %s %s responded with %d\n",
+							router.name, api.name, req.Method, req.RequestURI, w.Code,
+						)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestVariantsRepeated serves the GitHub examples of the path variants and a
+// case variant again and again like benchVariant, and checks that every
+// response is the one to the first request, e.g. still a 301 redirect.
+func TestVariantsRepeated(t *testing.T) {
+	const repeat = 5
+
+	paths := []string{"/Users/julienschmidt/Repos"}
+	for _, example := range pathVariantExamples {
+		paths = append(paths, example.path)
+	}
+
+	for _, router := range routers {
+		if _, ok := routersWithoutParams[router.name]; ok {
+			continue
+		}
+		loadCaseInsensitive = caseInsensitiveRouters[router.name]
+		r := loadParams(router.load, githubAPI)
+		loadCaseInsensitive = false
+
+		for _, path := range paths {
+			v := newVariantRequest(newRawRequest("GET", path))
+			first := httptest.NewRecorder()
+			v.serve(r, first)
+
+			for i := 1; i < repeat; i++ {
+				w := httptest.NewRecorder()
+				v.serve(r, w)
+				if w.Code != first.Code || w.Header().Get("Location") != first.Header().Get("Location") {
+					t.Errorf(
+						"%s: GET %s responded with %d %q the %d. time; expected %d %q like the first time\n",
+						router.name, path, w.Code, w.Header().Get("Location"), i+1,
+						first.Code, first.Header().Get("Location"),
+					)
+					break
+				}
+			}
+		}
+	}
+}
+
+// The benchmarks of the variants are in the _test.go file of each router.
+// The variants are parsed like the server does, see newRawRequest, routers
+// redirecting them respond with a Location header there.
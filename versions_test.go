@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+// the modules the test binary was built with, nil if it was not built in module
+// mode
+var buildInfo = readBuildInfo()
+
+func readBuildInfo() *debug.BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	// in GOPATH mode there is build info, but without any modules
+	if !ok || info.Main.Path == "" {
+		return nil
+	}
+	return info
+}
+
+// routerModule returns the module providing the package with the import path,
+// or nil if it is not known, e.g. for packages of the standard library.
+func routerModule(pkg string) *debug.Module {
+	if buildInfo == nil {
+		return nil
+	}
+	var found *debug.Module
+	for _, mod := range append([]*debug.Module{&buildInfo.Main}, buildInfo.Deps...) {
+		if pkg != mod.Path && !strings.HasPrefix(pkg, mod.Path+"/") {
+			continue
+		}
+		// the longest path wins, e.g. for nested modules
+		if found == nil || len(mod.Path) > len(found.Path) {
+			found = mod
+		}
+	}
+	return found
+}
+
+// gopathVersion returns the revision of the checkout of the package in the
+// GOPATH, e.g. v1.3.0-12-g8c199fb, or "" if it is not a git checkout.
+func gopathVersion(pkg string) string {
+	for _, dir := range filepath.SplitList(build.Default.GOPATH) {
+		dir = filepath.Join(dir, "src", filepath.FromSlash(pkg))
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		out, err := exec.Command("git", "-C", dir, "describe", "--tags", "--always", "--dirty").Output()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	}
+	return ""
+}
+
+// routerVersion returns the module path and version of the package of a
+// router, e.g. github.com/gin-gonic/gin@v1.9.1, followed by the replacement of
+// the module if it is replaced. Without modules it is the revision of the
+// checkout in the GOPATH, see gopathVersion. Packages of the standard library
+// have the version of Go.
+func routerVersion(pkg string) string {
+	if mod := routerModule(pkg); mod != nil {
+		version := mod.Path + "@" + mod.Version
+		if mod.Replace != nil {
+			version += " => " + mod.Replace.Path
+			if mod.Replace.Version != "" && mod.Replace.Version != "(devel)" {
+				version += "@" + mod.Replace.Version
+			}
+		}
+		return version
+	}
+	if !strings.Contains(strings.Split(pkg, "/")[0], ".") {
+		return pkg + "@" + runtime.Version()
+	}
+	if buildInfo == nil {
+		if version := gopathVersion(pkg); version != "" {
+			return pkg + "@" + version + " (GOPATH)"
+		}
+	}
+	return pkg + "@unknown"
+}
+
+// versionLines returns the version of Go and of the package of every tested
+// router as configuration lines of the benchmark format, e.g.
+//
+//	go: go1.22.1
+//	router-gin: github.com/gin-gonic/gin@v1.9.1
+//
+// Tools like benchstat attach them to every result which follows, so each
+// result records the versions it was measured with.
+func versionLines() []string {
+	lines := []string{"go: " + runtime.Version()}
+	for _, router := range routers {
+		if router.pkg == "" || !isTested(router.name) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("router-%s: %s", strings.ToLower(router.name), routerVersion(router.pkg)))
+	}
+	return lines
+}
+
+// printVersions prints the version lines, see versionLines.
+func printVersions() {
+	for _, line := range versionLines() {
+		fmt.Println(line)
+	}
+}
+
+func TestRouterVersions(t *testing.T) {
+	if buildInfo == nil {
+		t.Skip("the test binary was not built in module mode")
+	}
+	for _, router := range routers {
+		if router.pkg == "" || !strings.Contains(strings.Split(router.pkg, "/")[0], ".") {
+			continue
+		}
+		if mod := routerModule(router.pkg); mod == nil || mod.Version == "" {
+			t.Errorf("%s: no module version for %s", router.name, router.pkg)
+		}
+	}
+}
+
+func TestAdapterVersionLines(t *testing.T) {
+	defer func(args []string, re *regexp.Regexp, n int) {
+		os.Args, benchRe, routers = args, re, routers[:n]
+	}(os.Args, benchRe, len(routers))
+
+	// registered by the adapters of the command candidate
+	registerRouter(benchRouter{name: "HttpRouter@old", pkg: "github.com/julienschmidt/httprouter"})
+	registerRouter(benchRouter{name: "HttpRouter@new", pkg: "candidate/github.com/julienschmidt/httprouter"})
+	os.Args = []string{os.Args[0], "-test.bench=Adapters/HttpRouter"}
+	benchRe = nil
+
+	lines := strings.Join(versionLines(), "\n")
+	for _, version := range []string{"old", "new"} {
+		if !strings.Contains(lines, "router-httprouter@"+version+": ") {
+			t.Errorf("no version of HttpRouter@%s for -bench=Adapters/HttpRouter in:\n%s", version, lines)
+		}
+	}
+	if isTested("Gin") {
+		t.Error("Gin is tested with -bench=Adapters/HttpRouter")
+	}
+}
+
+func TestGopathVersion(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	gopath := t.TempDir()
+	dir := filepath.Join(gopath, "src", "example.com", "router")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.0.0"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v\n%s", args[0], err, out)
+		}
+	}
+
+	defer func(gopath string) { build.Default.GOPATH = gopath }(build.Default.GOPATH)
+	build.Default.GOPATH = gopath
+	if version := gopathVersion("example.com/router"); version != "v1.0.0" {
+		t.Errorf("gopathVersion of a tagged checkout: %q; expected v1.0.0", version)
+	}
+	if version := gopathVersion("example.com/other"); version != "" {
+		t.Errorf("gopathVersion of a missing checkout: %q; expected none", version)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !novulcan
+// +build !novulcan
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+
+	vulcan "github.com/mailgun/route"
+)
+
+var _ = registerRouter(benchRouter{
+	name:            "Vulcan",
+	pkg:             "github.com/mailgun/route",
+	load:            loadVulcan,
+	loadConstrained: loadVulcanConstrained,
+	hosts:           true,
+})
+
+// Mailgun Vulcan
+func vulcanHandler(w http.ResponseWriter, r *http.Request) {}
+
+func vulcanHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.URL.Query().Get("name"))
+}
+
+func loadVulcan(routes []route) http.Handler {
+	hostRe := regexp.MustCompile(":([^.]*)")
+	pathRe := regexp.MustCompile(":([^/]*)")
+	mux := vulcan.NewMux()
+	for _, route := range routes {
+		var h http.HandlerFunc = httpHandlerFunc
+		if loadTestHandler {
+			h = httpHandlerFuncTest
+			if route.host != "" {
+				h = httpHandlerFuncTestLabel(route.host)
+			}
+		}
+
+		path := pathRe.ReplaceAllString(route.path, "<$1>")
+		expr := fmt.Sprintf(`Method("%s") && Path("%s")`, route.method, path)
+		if route.host != "" {
+			host := hostRe.ReplaceAllString(route.host, "<$1>")
+			expr = fmt.Sprintf(`Host("%s") && %s`, host, expr)
+		}
+		if err := mux.HandleFunc(expr, h); err != nil {
+			panic(err)
+		}
+	}
+	return httpMiddlewares(mux)
+}
+
+func loadVulcanSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	re := regexp.MustCompile(":([^/]*)")
+	mux := vulcan.NewMux()
+	path = re.ReplaceAllString(path, "<$1>")
+	expr := fmt.Sprintf(`Method("%s") && Path("%s")`, method, path)
+	if err := mux.HandleFunc(expr, handler); err != nil {
+		panic(err)
+	}
+	return mux
+}
+
+func loadVulcanConstrained(routes []constrainedRoute) http.Handler {
+	re := regexp.MustCompile(":([^/]*)")
+	mux := vulcan.NewMux()
+	for _, route := range routes {
+		var h http.HandlerFunc = httpHandlerFunc
+		if loadTestHandler {
+			h = httpHandlerFuncTestLabel(route.constraint())
+		}
+
+		path := re.ReplaceAllString(route.path, "<$1>")
+		expr := fmt.Sprintf(`Method("%s") && Path("%s")`, route.method, path)
+		if route.header != "" {
+			expr += fmt.Sprintf(` && Header("%s", "%s")`, route.header, route.value)
+		}
+		if route.query != "" {
+			panic("Vulcan can not match query parameters")
+		}
+		if err := mux.HandleFunc(expr, h); err != nil {
+			panic(err)
+		}
+	}
+	return mux
+}
@@ -0,0 +1,222 @@
+//go:build !novulcan
+// +build !novulcan
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkVulcan_Param(b *testing.B) {
+	router := loadVulcanSingle("GET", "/user/:name", vulcanHandler)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkVulcan_Param5(b *testing.B) {
+	router := loadVulcanSingle("GET", fiveColon, vulcanHandler)
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkVulcan_Param20(b *testing.B) {
+	router := loadVulcanSingle("GET", twentyColon, vulcanHandler)
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkVulcan_ParamWrite(b *testing.B) {
+	router := loadVulcanSingle("GET", "/user/:name", vulcanHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// No encoded parameters (see encoded_test.go), Vulcan does not hand out
+// parameters to write, see routersWithoutParams.
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkVulcan_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Vulcan"], req)
+}
+
+// Param
+func BenchmarkVulcan_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Vulcan"], req)
+}
+
+// All routes
+func BenchmarkVulcan_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Vulcan"], githubAPI)
+}
+
+// Loading all routes
+func BenchmarkVulcan_GithubLoad(b *testing.B) {
+	benchLoad(b, loadVulcan, githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkVulcan_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Vulcan"], req)
+}
+
+// One Param
+func BenchmarkVulcan_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Vulcan"], req)
+}
+
+// Two Params
+func BenchmarkVulcan_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Vulcan"], req)
+}
+
+// All Routes
+func BenchmarkVulcan_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Vulcan"], gplusAPI)
+}
+
+// Loading all routes
+func BenchmarkVulcan_GPlusLoad(b *testing.B) {
+	benchLoad(b, loadVulcan, gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkVulcan_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Vulcan"], req)
+}
+
+// One Param
+func BenchmarkVulcan_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Vulcan"], req)
+}
+
+// Two Params
+func BenchmarkVulcan_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Vulcan"], req)
+}
+
+// All Routes
+func BenchmarkVulcan_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Vulcan"], parseAPI)
+}
+
+// Loading all routes
+func BenchmarkVulcan_ParseLoad(b *testing.B) {
+	benchLoad(b, loadVulcan, parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkVulcan_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Vulcan"], staticRoutes)
+}
+
+// Loading all routes
+func BenchmarkVulcan_StaticLoad(b *testing.B) {
+	benchLoad(b, loadVulcan, staticRoutes)
+}
+
+// Path variants, see variants_test.go
+
+// Trailing slash
+func BenchmarkVulcan_GithubTrailingSlash(b *testing.B) {
+	req := newRawRequest("GET", "/users/gordon/")
+	benchVariant(b, githubRouters["Vulcan"], req)
+}
+
+// Double slash
+func BenchmarkVulcan_GithubDoubleSlash(b *testing.B) {
+	req := newRawRequest("GET", "//gists")
+	benchVariant(b, githubRouters["Vulcan"], req)
+}
+
+// Dot segment
+func BenchmarkVulcan_GithubDotSegment(b *testing.B) {
+	req := newRawRequest("GET", "/repos/./julienschmidt/httprouter")
+	benchVariant(b, githubRouters["Vulcan"], req)
+}
+
+// Middlewares, see middleware_test.go
+
+// All routes with 1 middleware
+func BenchmarkVulcan_GithubAllMiddleware1(b *testing.B) {
+	router := loadMiddlewareRouter(loadVulcan, githubAPI, 1)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 5 middlewares
+func BenchmarkVulcan_GithubAllMiddleware5(b *testing.B) {
+	router := loadMiddlewareRouter(loadVulcan, githubAPI, 5)
+	benchRoutes(b, router, githubAPI)
+}
+
+// All routes with 10 middlewares
+func BenchmarkVulcan_GithubAllMiddleware10(b *testing.B) {
+	router := loadMiddlewareRouter(loadVulcan, githubAPI, 10)
+	benchRoutes(b, router, githubAPI)
+}
+
+// Reloading routes, see reload_test.go
+
+// Loading all routes into a new router and swapping it in while serving
+func BenchmarkVulcan_GithubReload(b *testing.B) {
+	benchReload(b, loadVulcan, githubAPI)
+}
+
+// Host matching, see tenant_test.go
+
+// Param on the main domain
+func BenchmarkVulcan_TenantMainParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "http://api.example.com/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, tenantRouters["Vulcan"], req)
+}
+
+// Param on a tenant subdomain
+func BenchmarkVulcan_TenantParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "http://acme.api.example.com/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, tenantRouters["Vulcan"], req)
+}
+
+// All routes
+func BenchmarkVulcan_TenantAll(b *testing.B) {
+	benchHostRoutes(b, tenantRouters["Vulcan"], tenantAPI)
+}
+
+// Header and query routing, see constrained_test.go
+
+// Param with header
+func BenchmarkVulcan_HeaderParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	benchRequest(b, headerRouters["Vulcan"], req)
+}
+
+// All routes with header
+func BenchmarkVulcan_HeaderAll(b *testing.B) {
+	benchConstrainedRoutes(b, headerRouters["Vulcan"], headerAPI)
+}
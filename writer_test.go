@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func init() {
+	features = append(features, feature{
+		name: "Response headers",
+		check: func(router string, load func(routes []route) http.Handler) string {
+			h := load(githubAPI)
+			w := new(recordingResponseWriter)
+			h.ServeHTTP(w, newRawRequest("GET", "/repos/julienschmidt/httprouter/stargazers"))
+
+			var names []string
+			for name := range w.Header() {
+				names = append(names, name)
+			}
+			if len(names) == 0 {
+				return "-"
+			}
+			sort.Strings(names)
+			return strings.Join(names, ", ")
+		},
+	})
+}
+
+// TestRecordingResponseWriter checks that the recording writer, reused for
+// all routes, keeps the same response as httptest.ResponseRecorder.
+func TestRecordingResponseWriter(t *testing.T) {
+	loadTestHandler = true
+
+	for _, router := range routers {
+		r := router.load(githubAPI)
+		w := new(recordingResponseWriter)
+
+		for _, route := range githubAPI {
+			req, _ := http.NewRequest(route.method, route.path, nil)
+			req.RequestURI = route.path
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			r.ServeHTTP(w, req)
+
+			// the recorder sniffs the Content-Type if there is none, like the
+			// server, so only the headers set by the router are compared
+			headers := make(http.Header)
+			for name := range w.Header() {
+				headers[name] = rec.Header()[name]
+			}
+			if w.code != rec.Code || string(w.body) != rec.Body.String() || !reflect.DeepEqual(w.Header(), headers) {
+				t.Errorf(
+					"%s: %d %v %q; expected %d %v %q for %s %s\n",
+					router.name, w.code, w.Header(), w.body, rec.Code, rec.Header(), rec.Body.String(), route.method, route.path,
+				)
+			}
+			w.reset()
+		}
+	}
+
+	loadTestHandler = false
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build zeus
+// +build zeus
+
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/daryl/zeus"
+)
+
+var _ = registerRouter(benchRouter{name: "Zeus", pkg: "github.com/daryl/zeus", load: loadZeus})
+
+// Zeus
+func zeusHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, zeus.Var(r, "name"))
+}
+
+func zeusHandlerParams(names []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, name := range names {
+			io.WriteString(w, zeus.Var(r, name))
+			io.WriteString(w, "\n")
+		}
+	}
+}
+
+func loadZeus(routes []route) http.Handler {
+	h := http.HandlerFunc(httpHandlerFunc)
+	if loadTestHandler {
+		h = http.HandlerFunc(httpHandlerFuncTest)
+	}
+
+	m := zeus.New()
+	for _, route := range routes {
+		if loadParamsHandler {
+			h = zeusHandlerParams(paramNames(route.path))
+		}
+		switch route.method {
+		case "GET":
+			m.GET(route.path, h)
+		case "POST":
+			m.POST(route.path, h)
+		case "PUT":
+			m.PUT(route.path, h)
+		case "DELETE":
+			m.DELETE(route.path, h)
+		default:
+			panic("Unknow HTTP method: " + route.method)
+		}
+	}
+	return httpMiddlewares(m)
+}
+
+func loadZeusSingle(method, path string, handler http.HandlerFunc) http.Handler {
+	m := zeus.New()
+	switch method {
+	case "GET":
+		m.GET(path, handler)
+	case "POST":
+		m.POST(path, handler)
+	case "PUT":
+		m.PUT(path, handler)
+	case "DELETE":
+		m.DELETE(path, handler)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return m
+}
@@ -0,0 +1,119 @@
+//go:build zeus
+// +build zeus
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Micro Benchmarks
+
+// Route with Param (no write)
+func BenchmarkZeus_Param(b *testing.B) {
+	router := loadZeusSingle("GET", "/user/:name", http.HandlerFunc(httpHandlerFunc))
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 5 Params (no write)
+func BenchmarkZeus_Param5(b *testing.B) {
+	router := loadZeusSingle("GET", fiveColon, http.HandlerFunc(httpHandlerFunc))
+
+	r, _ := http.NewRequest("GET", fiveRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with 20 Params (no write)
+func BenchmarkZeus_Param20(b *testing.B) {
+	router := loadZeusSingle("GET", twentyColon, http.HandlerFunc(httpHandlerFunc))
+
+	r, _ := http.NewRequest("GET", twentyRoute, nil)
+	benchRequest(b, router, r)
+}
+
+// Route with Param and write
+func BenchmarkZeus_ParamWrite(b *testing.B) {
+	router := loadZeusSingle("GET", "/user/:name", zeusHandlerWrite)
+
+	r, _ := http.NewRequest("GET", "/user/gordon", nil)
+	benchRequest(b, router, r)
+}
+
+// GitHub API, see github_test.go
+
+// Static
+func BenchmarkZeus_GithubStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/user/repos", nil)
+	benchRequest(b, githubRouters["Zeus"], req)
+}
+
+// Param
+func BenchmarkZeus_GithubParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/repos/julienschmidt/httprouter/stargazers", nil)
+	benchRequest(b, githubRouters["Zeus"], req)
+}
+
+// All routes
+func BenchmarkZeus_GithubAll(b *testing.B) {
+	benchRoutes(b, githubRouters["Zeus"], githubAPI)
+}
+
+// Google+ API, see gplus_test.go
+
+// Static
+func BenchmarkZeus_GPlusStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people", nil)
+	benchRequest(b, gplusRouters["Zeus"], req)
+}
+
+// One Param
+func BenchmarkZeus_GPlusParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327", nil)
+	benchRequest(b, gplusRouters["Zeus"], req)
+}
+
+// Two Params
+func BenchmarkZeus_GPlus2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/people/118051310819094153327/activities/123456789", nil)
+	benchRequest(b, gplusRouters["Zeus"], req)
+}
+
+// All Routes
+func BenchmarkZeus_GPlusAll(b *testing.B) {
+	benchRoutes(b, gplusRouters["Zeus"], gplusAPI)
+}
+
+// Parse API, see parse_test.go
+
+// Static
+func BenchmarkZeus_ParseStatic(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/users", nil)
+	benchRequest(b, parseRouters["Zeus"], req)
+}
+
+// One Param
+func BenchmarkZeus_ParseParam(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go", nil)
+	benchRequest(b, parseRouters["Zeus"], req)
+}
+
+// Two Params
+func BenchmarkZeus_Parse2Params(b *testing.B) {
+	req, _ := http.NewRequest("GET", "/1/classes/go/123456789", nil)
+	benchRequest(b, parseRouters["Zeus"], req)
+}
+
+// All Routes
+func BenchmarkZeus_ParseAll(b *testing.B) {
+	benchRoutes(b, parseRouters["Zeus"], parseAPI)
+}
+
+// Static routes, see static_test.go
+
+// All routes
+func BenchmarkZeus_StaticAll(b *testing.B) {
+	benchRoutes(b, staticRouters["Zeus"], staticRoutes)
+}